@@ -18,6 +18,8 @@ if [[ "$allow_failures" == "1" ]]; then
     go run -v  ./examples/logging/logging.go -logfile example.log || exit 0
     echo "example.log output:"
     (ls example.log && cat example.log| sed -e "s/Authorization: Bearer .*/Authorization: Bearer <REDACTED>/g") || exit 0
+    echo "Running tracing ..."
+    go run -v  ./examples/tracing/tracing.go || exit 0
 else
     echo "Running examples and gating on failures..."
     set +e
@@ -28,6 +30,8 @@ else
     go run -v  ./examples/logging/logging.go -logfile example.log || exit 1
     echo "example.log output:"
     (ls example.log && cat example.log| sed -e "s/Authorization: Bearer .*/Authorization: Bearer <REDACTED>/g") || exit 1
+    echo "Running tracing ..."
+    go run -v  ./examples/tracing/tracing.go || exit 1
     echo "Running mock ..."
     go run -v ./examples/mock/mock.go || exit 1
 fi
@@ -25,6 +25,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"syscall"
 
@@ -379,6 +380,68 @@ func Login(cmd *cobra.Command, authFlow func(map[string]string, *cobra.Command)
 	return context, nil
 }
 
+// LoggedOut describes a cached context removed by Logout
+type LoggedOut struct {
+	Tenant string `json:"tenant"`
+	// RefreshTokenRevoked is false if the context had no refresh token or revoking it failed
+	RefreshTokenRevoked bool   `json:"refresh_token_revoked"`
+	RevokeError         string `json:"revoke_error,omitempty"`
+}
+
+// Logout revokes the refresh tokens of the cached contexts of the current tenant, or of every tenant if
+// allTenants is set, and deletes them from .scloud_context. The contexts are deleted even if revoking fails, e.g.
+// because the refresh token has expired. Returns the contexts removed.
+func Logout(cmd *cobra.Command, allTenants bool) ([]LoggedOut, error) {
+	profile, err := GetEnvironmentProfile()
+	if err != nil {
+		return nil, err
+	}
+	clientID, err := gets(profile, "client_id")
+	if err != nil {
+		return nil, err
+	}
+
+	// Get all the contexts that is associated with the clientID
+	tomlContexts, ok := ctxCache.Get(clientID).(*toml.Tree)
+	if !ok {
+		return nil, nil
+	}
+
+	tenants := []string{getTenantName()}
+	if allTenants {
+		tenants = tomlContexts.Keys()
+		sort.Strings(tenants)
+	}
+
+	removed := []LoggedOut{}
+	for _, tenant := range tenants {
+		if !tomlContexts.Has(tenant) {
+			continue
+		}
+		result := LoggedOut{Tenant: tenant}
+		context := &idp.Context{}
+		if tenantContext, ok := tomlContexts.Get(tenant).(*toml.Tree); ok && FromToml(context, tenantContext) == nil && context.RefreshToken != "" {
+			if err := revokeRefreshToken(profile, tenant, context.RefreshToken); err != nil {
+				result.RevokeError = err.Error()
+			} else {
+				result.RefreshTokenRevoked = true
+			}
+		}
+		if err := tomlContexts.Delete(tenant); err != nil {
+			return removed, err
+		}
+		removed = append(removed, result)
+	}
+
+	// update ctxCache
+	if len(tomlContexts.Keys()) == 0 {
+		err = ctxCache.Delete(clientID)
+	} else if len(removed) > 0 {
+		ctxCache.Set(clientID, tomlContexts)
+	}
+	return removed, err
+}
+
 // Load config and settings.
 func loadConfigs() error {
 	if err := loadConfig(); err != nil {
@@ -624,6 +687,8 @@ func ToMap(ctx *idp.Context) map[string]interface{} {
 }
 
 // GlogWrapper is used to wrap glog.info() in a Print() function usable by splunk-cloud-sdk-go
+//
+// Deprecated: use NewGlogHandler with util.LogTransport
 type GlogWrapper struct {
 }
 
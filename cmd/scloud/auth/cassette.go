@@ -0,0 +1,59 @@
+package auth
+
+import (
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/splunk/splunk-cloud-sdk-go/util"
+)
+
+// cassette records or replays the requests made by scloud when SCLOUD_CASSETTE names a cassette file, so that
+// scloud tests can be recorded against a real environment once and run offline afterwards:
+//
+//	SCLOUD_CASSETTE=<file>                        the cassette to record or replay, see util.Recorder
+//	SCLOUD_RECORD_MODE=replay|record|replay-or-record|passthrough  replay by default
+//	SCLOUD_CASSETTE_REPLACE=<old>=<new>,...       values scrubbed from recordings, e.g. mytenant=testtenant
+//
+// The recorder is shared by requests to services and the IdP.
+var cassette struct {
+	once     sync.Once
+	recorder *util.Recorder
+}
+
+// withCassette returns the cassette recorder sending requests with transport if SCLOUD_CASSETTE is set, and
+// transport otherwise
+func withCassette(transport http.RoundTripper) http.RoundTripper {
+	path := os.Getenv("SCLOUD_CASSETTE")
+	if path == "" {
+		return transport
+	}
+	cassette.once.Do(func() {
+		mode, err := util.ParseRecorderMode(os.Getenv("SCLOUD_RECORD_MODE"))
+		if err != nil {
+			util.Fatal(err.Error())
+		}
+		recorder, err := util.NewRecorder(Abspath(path), util.RecorderOptions{
+			Mode:         mode,
+			Transport:    transport,
+			Replacements: parseReplacements(os.Getenv("SCLOUD_CASSETTE_REPLACE")),
+		})
+		if err != nil {
+			util.Fatal(err.Error())
+		}
+		cassette.recorder = recorder
+	})
+	return cassette.recorder
+}
+
+// parseReplacements parses comma separated <old>=<new> pairs
+func parseReplacements(s string) map[string]string {
+	replacements := map[string]string{}
+	for _, pair := range strings.Split(s, ",") {
+		if old, replacement, ok := strings.Cut(pair, "="); ok && strings.TrimSpace(old) != "" {
+			replacements[strings.TrimSpace(old)] = strings.TrimSpace(replacement)
+		}
+	}
+	return replacements
+}
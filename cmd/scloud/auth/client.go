@@ -1,11 +1,9 @@
 package auth
 
 import (
-	"crypto/tls"
-	"crypto/x509"
 	"errors"
 	"fmt"
-	"io/ioutil"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"os"
@@ -119,43 +117,19 @@ func newClient(svc *Service) *sdk.Client {
 		hostPort = host + ":" + port
 	}
 
-	tlsConfig := &tls.Config{InsecureSkipVerify: isInsecure()}
-
 	region := getRegion()
 	tenantScopedSetting := getTenantScoped()
 	if tenantScopedSetting != false {
 		tenantScoped = tenantScopedSetting
 	}
 
-	// Load client cert
-	caCert := getCaCert()
-
-	// -insecure=false -scheme=https -ca-cert=<path-to-file.crt>
-	if !isInsecure() && scheme == defaultScheme && caCert != "" {
-		rootCAs, _ := x509.SystemCertPool()
-		if rootCAs == nil {
-			rootCAs = x509.NewCertPool()
-		}
-		certs, err := ioutil.ReadFile(caCert)
-		if err != nil {
-			util.Warning("Failed to append %q to RootCAs: %v", caCert, err)
-		}
-		if ok := rootCAs.AppendCertsFromPEM(certs); !ok {
-			util.Warning("No certs appended, using system certs only")
-		}
-		// set the RootCA
-		tlsConfig.RootCAs = rootCAs
-	}
-
 	var scloudVersion string
 	scloudVersion = fmt.Sprintf("%s/%s", version.UserAgent, version.ScloudVersion)
 
 	var roundTripper http.RoundTripper
 
-	roundTripper = util.NewCustomSdkTransport(&GlogWrapper{}, &http.Transport{
-		TLSClientConfig: tlsConfig,
-		Proxy:           http.ProxyFromEnvironment,
-	})
+	// requests and responses are logged to glog at info level, as they were before structured logging
+	roundTripper = util.NewLogTransport(slog.New(NewGlogHandler()), newTransport(), util.LogTransportOptions{Level: slog.LevelInfo})
 
 	testdryrun, _ := cf.GlobalFlags["testhookdryrun"].(bool)
 	if testdryrun {
@@ -187,6 +161,23 @@ func newClient(svc *Service) *sdk.Client {
 	return result
 }
 
+// Returns the transport used for requests to services and the IdP, trusting the ca-cert from passed-in options
+// or local settings unless TLS certificate validation is disabled with insecure. Requests are recorded to or
+// replayed from the cassette named by SCLOUD_CASSETTE if set.
+func newTransport() http.RoundTripper {
+	config := &util.TransportConfig{InsecureSkipVerify: isInsecure()}
+	// -insecure=false -ca-cert=<path-to-file.crt>
+	if caCert := getCaCert(); !isInsecure() && caCert != "" {
+		config.RootCAFiles = []string{caCert}
+	}
+	transport, err := util.NewTransport(config)
+	if err != nil {
+		util.Warning("%v, using system certs only", err)
+		transport, _ = util.NewTransport(&util.TransportConfig{})
+	}
+	return withCassette(transport)
+}
+
 // Returns the api service client pointing to the New Client in the SDK.
 func apiClient() *sdk.Client {
 	// getTenantName() will prompt for tenant if none specified
@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/golang/glog"
+)
+
+// glogWriter writes to glog at a fixed severity
+type glogWriter func(args ...interface{})
+
+func (w glogWriter) Write(p []byte) (int, error) {
+	w(string(p))
+	return len(p), nil
+}
+
+// dropTime removes the time from records since glog adds its own
+func dropTime(groups []string, a slog.Attr) slog.Attr {
+	if len(groups) == 0 && a.Key == slog.TimeKey {
+		return slog.Attr{}
+	}
+	return a
+}
+
+// GlogHandler is a slog.Handler which formats records as text and writes them to glog at the severity
+// matching the record's level, debug records are only written at glog verbosity 1 or higher (-v=1)
+type GlogHandler struct {
+	info, warning, error slog.Handler
+}
+
+// NewGlogHandler creates a GlogHandler
+func NewGlogHandler() *GlogHandler {
+	opts := &slog.HandlerOptions{Level: slog.LevelDebug, ReplaceAttr: dropTime}
+	return &GlogHandler{
+		info:    slog.NewTextHandler(glogWriter(glog.Info), opts),
+		warning: slog.NewTextHandler(glogWriter(glog.Warning), opts),
+		error:   slog.NewTextHandler(glogWriter(glog.Error), opts),
+	}
+}
+
+func (h *GlogHandler) handler(level slog.Level) slog.Handler {
+	switch {
+	case level >= slog.LevelError:
+		return h.error
+	case level >= slog.LevelWarn:
+		return h.warning
+	}
+	return h.info
+}
+
+// Enabled reports whether records at level are written
+func (h *GlogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= slog.LevelInfo || bool(glog.V(1))
+}
+
+// Handle writes the record to glog
+func (h *GlogHandler) Handle(ctx context.Context, r slog.Record) error {
+	return h.handler(r.Level).Handle(ctx, r)
+}
+
+// WithAttrs returns a GlogHandler whose records include attrs
+func (h *GlogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &GlogHandler{
+		info:    h.info.WithAttrs(attrs),
+		warning: h.warning.WithAttrs(attrs),
+		error:   h.error.WithAttrs(attrs),
+	}
+}
+
+// WithGroup returns a GlogHandler whose record attributes are qualified by name
+func (h *GlogHandler) WithGroup(name string) slog.Handler {
+	return &GlogHandler{
+		info:    h.info.WithGroup(name),
+		warning: h.warning.WithGroup(name),
+		error:   h.error.WithGroup(name),
+	}
+}
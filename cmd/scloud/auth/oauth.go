@@ -18,11 +18,13 @@ package auth
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/pelletier/go-toml"
 	"github.com/splunk/splunk-cloud-sdk-go/idp"
+	"github.com/splunk/splunk-cloud-sdk-go/util"
 )
 
 // Returns the value corresponding to the given key, from the given map.
@@ -134,8 +136,8 @@ func PkceFlow(profile map[string]string, cmd *cobra.Command) (*idp.Context, erro
 	hostURL := idp.HostURLConfig{TenantScoped: tenantScoped, Tenant: tenant, Region: region}
 	tr := idp.NewPKCERetriever(clientID, redirectURI, idp.DefaultOIDCScopes, username, password, idpHost, overrideAuthURL, hostURL)
 
-	// Allow on-prem to use insecure to bypass TLS Verification
-	tr.Insecure = isInsecure()
+	// Allow on-prem to use insecure to bypass TLS Verification, or ca-cert to trust its certificate
+	tr.SetTransport(newTransport())
 	return tr.PKCEFlow(clientID, redirectURI, scope, username, password)
 }
 
@@ -182,7 +184,7 @@ func RefreshFlow(profile map[string]string, cmd *cobra.Command) (*idp.Context, e
 
 	tr := idp.NewRefreshTokenRetriever(clientID, scope, refreshToken, idpHost, overrideAuthURL, hostURL)
 
-	tr.Insecure = isInsecure()
+	tr.SetTransport(newTransport())
 	return tr.Refresh(clientID, scope, refreshToken)
 }
 
@@ -218,7 +220,7 @@ func DeviceFlow(profile map[string]string, cmd *cobra.Command) (*idp.Context, er
 	hostURL := idp.HostURLConfig{TenantScoped: tenantScoped, Tenant: tenant, Region: region}
 
 	tr := idp.NewDeviceFlowRetriever(clientID, idpHost, overrideAuthURL, hostURL)
-	tr.Insecure = isInsecure()
+	tr.SetTransport(newTransport())
 
 	deviceCodeInfo, err := tr.GetDeviceCodes(clientID, defaultScope)
 	if err != nil {
@@ -234,6 +236,65 @@ func DeviceFlow(profile map[string]string, cmd *cobra.Command) (*idp.Context, er
 	return tr.DeviceFlow(clientID, deviceCodeInfo.DeviceCode, deviceCodeInfo.ExpiresIn, deviceCodeInfo.Interval)
 }
 
+// defaultBrowserRedirectURI is listened on by the browser flow unless the profile's redirect_uri is a loopback
+// http url, a free port is chosen
+const defaultBrowserRedirectURI = "http://127.0.0.1/"
+
+func BrowserFlow(profile map[string]string, cmd *cobra.Command) (*idp.Context, error) {
+	clientID, err := gets(profile, "client_id")
+	if err != nil {
+		return nil, err
+	}
+	scope, err := getsd(profile, "scope", "openid")
+	if err != nil {
+		return nil, err
+	}
+	idpHost, err := gets(profile, "idp_host")
+	if err != nil {
+		return nil, err
+	}
+
+	// Override the redirect_uri from the profile with --redirect-uri, the profile's is only usable if it can be
+	// listened on
+	var redirectURI string
+	if cmd != nil {
+		redirectURI, _ = cmd.Flags().GetString("redirect-uri")
+	}
+	if redirectURI == "" {
+		redirectURI = defaultBrowserRedirectURI
+		if uri, ok := profile["redirect_uri"]; ok && (strings.HasPrefix(uri, "http://localhost") || strings.HasPrefix(uri, "http://127.0.0.1")) {
+			redirectURI = uri
+		}
+	}
+
+	// tenantScoped = true for multi-region hostnames
+	tenantScoped := enableTenantScope
+	tenant := getTenantName()
+	region := getRegion()
+
+	tenantScopedSetting := getTenantScoped()
+	if tenantScopedSetting != false {
+		tenantScoped = tenantScopedSetting
+	}
+
+	// Override idp_host from config file with -auth_url or auth_url in local settings
+	overrideAuthURL, _ := localSetting["auth-url"].(string)
+
+	hostURL := idp.HostURLConfig{TenantScoped: tenantScoped, Tenant: tenant, Region: region}
+
+	tr := idp.NewBrowserRetriever(clientID, redirectURI, scope, idpHost, overrideAuthURL, hostURL)
+	tr.SetTransport(newTransport())
+	tr.OpenURL = func(authorizeURL string) error {
+		fmt.Println("Opening the login page in your browser, if it does not open visit the URL below:")
+		fmt.Println(authorizeURL)
+		if err := idp.OpenBrowser(authorizeURL); err != nil {
+			util.Warning("failed to open browser: %s", err.Error())
+		}
+		return nil
+	}
+	return tr.GetTokenContext()
+}
+
 // Return the correct flow function
 func GetFlow(kind string) (func(map[string]string, *cobra.Command) (*idp.Context, error), error) {
 	switch kind {
@@ -243,6 +304,39 @@ func GetFlow(kind string) (func(map[string]string, *cobra.Command) (*idp.Context
 		return RefreshFlow, nil
 	case "device":
 		return DeviceFlow, nil
+	case "browser":
+		return BrowserFlow, nil
 	}
 	return nil, fmt.Errorf("bad profile kind: '%s'", kind)
 }
+
+// revokeRefreshToken revokes a refresh token issued to the profile's app for tenant, which also invalidates the
+// access tokens issued with it
+func revokeRefreshToken(profile map[string]string, tenant string, refreshToken string) error {
+	clientID, err := gets(profile, "client_id")
+	if err != nil {
+		return err
+	}
+	idpHost, err := gets(profile, "idp_host")
+	if err != nil {
+		return err
+	}
+
+	// tenantScoped = true for multi-region hostnames
+	tenantScoped := enableTenantScope
+	region := getRegion()
+
+	tenantScopedSetting := getTenantScoped()
+	if tenantScopedSetting != false {
+		tenantScoped = tenantScopedSetting
+	}
+
+	// Override idp_host from config file with -auth_url or auth_url in local settings
+	overrideAuthURL, _ := localSetting["auth-url"].(string)
+
+	hostURL := idp.HostURLConfig{TenantScoped: tenantScoped, Tenant: tenant, Region: region}
+
+	client := idp.NewClient(idpHost, overrideAuthURL, "", "", "", "", "", "", false, hostURL)
+	client.SetTransport(newTransport())
+	return client.Revoke(clientID, refreshToken, idp.TokenTypeHintRefreshToken)
+}
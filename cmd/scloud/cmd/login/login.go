@@ -28,6 +28,8 @@ func init() {
 	loginCmd.Flags().BoolP("use-refresh-token", "", false, "Whether to use refresh token authentication flow")
 	loginCmd.Flags().BoolP("use-pkce", "", false, "use PKCE authentication flow")
 	loginCmd.Flags().BoolP("use-device", "", false, "use device authentication flow")
+	loginCmd.Flags().BoolP("use-browser", "", false, "use browser authentication flow, supporting single sign-on")
+	loginCmd.Flags().StringP("redirect-uri", "", "", "the loopback redirect uri listened on by the browser authentication flow, e.g. http://127.0.0.1:8085/callback")
 
 	loginCmd.SetUsageTemplate(usageUtil.UsageTemplate)
 	loginCmd.SetHelpTemplate(usageUtil.HelpTemplate)
@@ -0,0 +1,27 @@
+package logout
+
+import (
+	"github.com/spf13/cobra"
+	impl "github.com/splunk/splunk-cloud-sdk-go/cmd/scloud/pkg/logout"
+	usageUtil "github.com/splunk/splunk-cloud-sdk-go/cmd/scloud/util"
+)
+
+// Cmd -- used to connection to rootCmd
+func Cmd() *cobra.Command {
+	return logoutCmd
+}
+
+// logoutCmd represents the logout command
+var logoutCmd = &cobra.Command{
+	Use:   "logout",
+	Short: "Log out of Splunk Cloud Services, revoking and removing the cached tokens",
+	RunE:  impl.Logout,
+}
+
+func init() {
+
+	logoutCmd.Flags().BoolP("all-tenants", "", false, "Whether to log out of every tenant of the current environment, rather than the current tenant only")
+
+	logoutCmd.SetUsageTemplate(usageUtil.UsageTemplate)
+	logoutCmd.SetHelpTemplate(usageUtil.HelpTemplate)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/splunk/splunk-cloud-sdk-go/cmd/scloud/cmd/ingest"
 	"github.com/splunk/splunk-cloud-sdk-go/cmd/scloud/cmd/kvstore"
 	"github.com/splunk/splunk-cloud-sdk-go/cmd/scloud/cmd/login"
+	"github.com/splunk/splunk-cloud-sdk-go/cmd/scloud/cmd/logout"
 	"github.com/splunk/splunk-cloud-sdk-go/cmd/scloud/cmd/ml"
 	"github.com/splunk/splunk-cloud-sdk-go/cmd/scloud/cmd/provisioner"
 	"github.com/splunk/splunk-cloud-sdk-go/cmd/scloud/cmd/search"
@@ -77,6 +78,7 @@ func init() {
 	rootCmd.AddCommand(ingest.Cmd())
 	rootCmd.AddCommand(kvstore.Cmd())
 	rootCmd.AddCommand(login.Cmd())
+	rootCmd.AddCommand(logout.Cmd())
 	rootCmd.AddCommand(ml.Cmd())
 	rootCmd.AddCommand(provisioner.Cmd())
 	rootCmd.AddCommand(search.Cmd())
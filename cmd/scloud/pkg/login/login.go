@@ -8,11 +8,13 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/splunk/splunk-cloud-sdk-go/cmd/scloud/auth"
 	"github.com/splunk/splunk-cloud-sdk-go/cmd/scloud/jsonx"
+	"github.com/splunk/splunk-cloud-sdk-go/util"
 )
 
 const refreshFlow = "refresh"
 const pkceFlow = "pkce"
 const deviceFlow = "device"
+const browserFlow = "browser"
 
 // TODO: Adding password handling
 type Options struct {
@@ -61,6 +63,10 @@ func Login(cmd *cobra.Command, args []string) error {
 
 // check whether the error contains 400s and 500s HTTP error
 func isHTTPError(err error) bool {
+	var httpErr *util.HTTPError
+	if errors.As(err, &httpErr) {
+		return true
+	}
 	regex := regexp.MustCompile(`(400|401|403|404|500|502|503|504){1}`)
 	return regex.MatchString(err.Error())
 }
@@ -99,6 +105,11 @@ func parseLoginOption(cmd *cobra.Command) (*Options, error) {
 		return nil, errors.New(`error parsing "use-device": ` + err.Error())
 	}
 
+	isBrowserFlow, err := cmd.Flags().GetBool("use-browser")
+	if err != nil {
+		return nil, errors.New(`error parsing "use-browser": ` + err.Error())
+	}
+
 	var authKind string
 
 	if isRefreshFlow {
@@ -107,6 +118,8 @@ func parseLoginOption(cmd *cobra.Command) (*Options, error) {
 		authKind = pkceFlow
 	} else if isDeviceFlow {
 		authKind = deviceFlow
+	} else if isBrowserFlow {
+		authKind = browserFlow
 	} else {
 		authKind, err = getAuthKindFromProfile()
 
@@ -0,0 +1,46 @@
+package logout
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/splunk/splunk-cloud-sdk-go/cmd/scloud/auth"
+	"github.com/splunk/splunk-cloud-sdk-go/cmd/scloud/jsonx"
+	"github.com/splunk/splunk-cloud-sdk-go/util"
+)
+
+// Logout -- impl
+func Logout(cmd *cobra.Command, args []string) error {
+
+	// Step 1: Setup
+	err := auth.LoginSetUp()
+	if err != nil {
+		return fmt.Errorf(`error logout setup: ` + err.Error())
+	}
+
+	// Step 2: Obtain options
+	allTenants, err := cmd.Flags().GetBool("all-tenants")
+	if err != nil {
+		return errors.New(`error parsing "all-tenants": ` + err.Error())
+	}
+
+	// Step 3: Revoke and remove the cached contexts
+	removed, err := auth.Logout(cmd, allTenants)
+	if err != nil {
+		return fmt.Errorf(`error logout: ` + err.Error())
+	}
+
+	// Step 4: Report what was removed
+	if len(removed) == 0 {
+		fmt.Println("No cached context found, already logged out")
+		return nil
+	}
+	for _, context := range removed {
+		if context.RevokeError != "" {
+			util.Warning("failed to revoke the refresh token of tenant %s, it was removed locally: %s", context.Tenant, context.RevokeError)
+		}
+	}
+	jsonx.Pprint(cmd, removed)
+	return nil
+}
@@ -0,0 +1,30 @@
+package test
+
+import (
+	"strings"
+	"testing"
+
+	utils "github.com/splunk/splunk-cloud-sdk-go/cmd/scloud/test/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogoutCmd(t *testing.T) {
+	loginCmd := "login --use-pkce --tenant " + utils.TestTenant + " --env " + utils.Env1 + " --uid " + utils.Username + " --pwd " + utils.Password
+	_, err, _ := utils.ExecuteCmd(loginCmd, t)
+	assert.Equal(t, nil, err)
+
+	logoutCmd := "logout --tenant " + utils.TestTenant + " --env " + utils.Env1
+	results, err, _ := utils.ExecuteCmd(logoutCmd, t)
+	assert.Equal(t, nil, err)
+	assert.True(t, strings.Contains(results, utils.TestTenant))
+
+	// the context is removed
+	command := "context list --tenant " + utils.TestTenant
+	success := utils.Execute_cmd_with_global_flags(command, "Bearer", t, false)
+	assert.Equal(t, false, success)
+
+	// logging out again is not an error
+	results, err, _ = utils.ExecuteCmd(logoutCmd, t)
+	assert.Equal(t, nil, err)
+	assert.True(t, strings.Contains(results, "already logged out"))
+}
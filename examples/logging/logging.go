@@ -14,7 +14,8 @@
  * under the License.
  */
 
-// This example demonstrates how to setup logging of requests/responses with the sdk using the standard Go "log" library.
+// This example demonstrates how to setup logging of requests/responses with the sdk using the standard Go "log" library,
+// with the sdk's requests and responses logged as structured "log/slog" records with credentials redacted.
 //
 // By default, this example logs to stout (for INFO level logs) and stderr (for ERROR level logs):
 //    ```$ go run -v ./examples/logging/logging.go```
@@ -27,6 +28,7 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"log/slog"
 	"os"
 
 	"github.com/splunk/splunk-cloud-sdk-go/services"
@@ -60,7 +62,7 @@ func main() {
 		Token:        testutils.TestAuthenticationToken,
 		Host:         testutils.TestSplunkCloudHost,
 		Tenant:       testutils.TestTenant,
-		RoundTripper: util.NewSdkTransport(logInfo),
+		RoundTripper: util.NewLogTransport(slog.New(slog.NewTextHandler(logInfo.Writer(), nil)), nil, util.LogTransportOptions{Level: slog.LevelInfo}),
 	}
 
 	client, err := services.NewClient(config)
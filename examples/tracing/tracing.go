@@ -0,0 +1,80 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+// This example demonstrates how to instrument the sdk with OpenTelemetry tracing and metrics, the spans and
+// metrics recorded are written to stdout:
+//    ```$ go run -v ./examples/tracing/tracing.go```
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/splunk/splunk-cloud-sdk-go/otelsdk"
+	"github.com/splunk/splunk-cloud-sdk-go/sdk"
+	"github.com/splunk/splunk-cloud-sdk-go/services"
+	"github.com/splunk/splunk-cloud-sdk-go/services/identity"
+	testutils "github.com/splunk/splunk-cloud-sdk-go/test/utils"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func main() {
+	ctx := context.Background()
+
+	// Setup OpenTelemetry to export spans and metrics to stdout
+	spanExporter, err := stdouttrace.New(stdouttrace.WithPrettyPrint())
+	exitOnError(err)
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(spanExporter))
+	defer tracerProvider.Shutdown(ctx)
+
+	metricExporter, err := stdoutmetric.New()
+	exitOnError(err)
+	meterProvider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(sdkmetric.NewPeriodicReader(metricExporter)))
+	defer meterProvider.Shutdown(ctx)
+
+	// Get client, instrumented using the providers above
+	fmt.Println("Get client")
+	client, err := sdk.NewClient(&services.Config{
+		Token:         testutils.TestAuthenticationToken,
+		Host:          testutils.TestSplunkCloudHost,
+		Tenant:        testutils.TestTenant,
+		RetryRequests: true,
+		Tracer: otelsdk.NewTracer(
+			otelsdk.WithTracerProvider(tracerProvider),
+			otelsdk.WithMeterProvider(meterProvider),
+		),
+	})
+	exitOnError(err)
+
+	// Requests made using a context which holds a span are recorded as children of that span
+	ctx, span := tracerProvider.Tracer("tracing-example").Start(ctx, "validate token")
+	input := identity.ValidateTokenQueryParams{Include: []identity.ValidateTokenincludeEnum{"principal", "tenant"}}
+	info, err := client.IdentityService.ValidateTokenWithContext(ctx, &input)
+	span.End()
+	exitOnError(err)
+	fmt.Printf("Success! Info: %+v\n", info)
+}
+
+func exitOnError(err error) {
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,257 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package idp
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"html"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"runtime"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// browserResponsePage is shown in the browser once the redirect from the IdP has been received
+const browserResponsePage = `<!DOCTYPE html>
+<html><head><title>%[1]s</title></head><body><p>%[1]s</p><p>%[2]s</p></body></html>`
+
+// OpenBrowser opens u in the user's default browser
+func OpenBrowser(u string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", u)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", u)
+	default:
+		cmd = exec.Command("xdg-open", u)
+	}
+	return cmd.Start()
+}
+
+// browserRedirect is the result of the redirect from the IdP to the loopback listener
+type browserRedirect struct {
+	code string
+	err  error
+}
+
+// BrowserFlow will authenticate using the "authorization code" flow with PKCE in the user's browser, see
+// BrowserFlowWithContext.
+func (c *Client) BrowserFlow(clientID, redirectURI, scope string, openURL func(authorizeURL string) error) (*Context, error) {
+	return c.BrowserFlowWithContext(context.Background(), clientID, redirectURI, scope, openURL)
+}
+
+// BrowserFlowWithContext will authenticate using the "authorization code" flow with PKCE in the user's browser,
+// which supports any identity provider the IdP federates with, e.g. SSO using SAML. It listens on the loopback
+// address of redirectURI, e.g. http://127.0.0.1:8085/callback, and calls openURL with the authorize url for the
+// user to open, e.g. using OpenBrowser. Once the user has authenticated the IdP redirects the browser to the
+// listener, and the authorization code received is exchanged for tokens. If redirectURI has no port, or port
+// 0, a free port is chosen, which requires the IdP to allow any port for loopback redirect URIs (RFC 8252).
+// The flow waits for the redirect until ctx is done.
+func (c *Client) BrowserFlowWithContext(ctx context.Context, clientID, redirectURI, scope string, openURL func(authorizeURL string) error) (*Context, error) {
+	redirect, err := url.Parse(redirectURI)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to parse redirect uri: %s", redirectURI))
+	}
+	if redirect.Scheme != "http" || !isLoopback(redirect.Hostname()) {
+		return nil, fmt.Errorf("redirect uri must be an http url on a loopback address such as http://127.0.0.1:8085/callback: %s", redirectURI)
+	}
+	port := redirect.Port()
+	if port == "" {
+		port = "0"
+	}
+	listener, err := net.Listen("tcp", net.JoinHostPort(redirect.Hostname(), port))
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to listen for the redirect on: %s", redirect.Host))
+	}
+	defer listener.Close()
+	if port == "0" {
+		redirect.Host = net.JoinHostPort(redirect.Hostname(), fmt.Sprint(listener.Addr().(*net.TCPAddr).Port))
+	}
+	if redirect.Path == "" {
+		redirect.Path = "/"
+	}
+	redirectURI = redirect.String()
+
+	cv, cc, err := createCodeChallenge(50)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get challenge code")
+	}
+	st, err := randomState()
+	if err != nil {
+		return nil, err
+	}
+	params := url.Values{
+		"client_id":             {clientID},
+		"code_challenge":        {cc},
+		"code_challenge_method": {"S256"},
+		"nonce":                 {st},
+		"redirect_uri":          {redirectURI},
+		"response_type":         {"code"},
+		"scope":                 {scope},
+		"state":                 {st}}
+	hostURL, err := c.scopedHostURL()
+	if err != nil {
+		return nil, err
+	}
+	authzURL := c.makeURL(hostURL, c.AuthorizePath) + "?" + params.Encode()
+
+	// receive the authorization code from the redirect
+	redirects := make(chan browserRedirect, 1)
+	mux := http.NewServeMux()
+	mux.HandleFunc(redirect.Path, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		var result browserRedirect
+		switch {
+		case query.Get("state") != st:
+			http.Error(w, "invalid state", http.StatusBadRequest)
+			return
+		case query.Get("error") != "":
+			result.err = fmt.Errorf("authorization failed: %s: %s", query.Get("error"), query.Get("error_description"))
+		case query.Get("code") == "":
+			result.err = errors.New("failed to retrieve valid authorization code from the redirect url")
+		default:
+			result.code = query.Get("code")
+		}
+		title, message := "Login succeeded", "You can close this window."
+		if result.err != nil {
+			title, message = "Login failed", result.err.Error()
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		fmt.Fprintf(w, browserResponsePage, html.EscapeString(title), html.EscapeString(message))
+		select {
+		case redirects <- result:
+		default:
+		}
+	})
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go server.Serve(listener) //nolint:errcheck
+	defer func() {
+		// let the browser receive the response page before closing the listener
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	if err := openURL(authzURL); err != nil {
+		return nil, errors.Wrap(err, "failed to open the authorize url")
+	}
+	var result browserRedirect
+	select {
+	case result = <-redirects:
+	case <-ctx.Done():
+		return nil, errors.Wrap(ctx.Err(), "failed to receive the redirect from the authorize endpoint")
+	}
+	if result.err != nil {
+		return nil, result.err
+	}
+
+	// exchange authorization code for token(s)
+	form := url.Values{
+		"client_id":     {clientID},
+		"code":          {result.code},
+		"code_verifier": {cv},
+		"grant_type":    {"authorization_code"},
+		"redirect_uri":  {redirectURI}}
+	tokenURL, err := c.clientTokenURL()
+	if err != nil {
+		return nil, err
+	}
+	request, err := newFormPost(ctx, tokenURL, form)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to create request to token endpoint url: %s", tokenURL))
+	}
+	return c.clientToken(request, tokenURL)
+}
+
+// isLoopback returns whether host is a loopback address or localhost
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// randomState returns an unguessable state value, binding a redirect to the request which caused it
+func randomState() (string, error) {
+	buff := make([]byte, 16)
+	if _, err := rand.Read(buff); err != nil {
+		return "", errors.Wrap(err, "failed to generate state")
+	}
+	return base64.RawURLEncoding.EncodeToString(buff), nil
+}
+
+// BrowserRetriever retries a request after getting a new access token from the identity provider using the authorization code flow with PKCE in the user's browser
+type BrowserRetriever struct {
+	*Client
+	// ClientID corresponding to a PKCE flow supported IdP client
+	ClientID string
+	// RedirectURI that has been allowlisted according to the ClientID, an http url on a loopback address which is listened on for the redirect
+	RedirectURI string
+	// Scope(s) to request, separated by spaces -- "openid email profile" is recommended for individual users
+	Scope string
+	// OpenURL is called with the authorize url for the user to open, by default it is printed to stderr and opened with OpenBrowser
+	OpenURL func(authorizeURL string) error
+}
+
+// NewBrowserRetriever initializes a new token context retriever
+//
+//	idpURL: should be of the form https://example.com or optionally https://example.com:port
+//	  - if "" is specified then SplunkCloudIdpURL will be used.
+func NewBrowserRetriever(clientID string, redirectURI string, scope string, idpHost string, overrideAuthURL string, hostURLConfig HostURLConfig) *BrowserRetriever {
+	return &BrowserRetriever{
+		Client:      makeClient(idpHost, overrideAuthURL, false, hostURLConfig),
+		ClientID:    clientID,
+		RedirectURI: redirectURI,
+		Scope:       scope,
+		OpenURL:     openInBrowser,
+	}
+}
+
+// openInBrowser prints the authorize url to stderr, in case no browser can be opened, and opens it
+func openInBrowser(authorizeURL string) error {
+	fmt.Fprintf(os.Stderr, "Opening the following URL in your browser to log in:\n%s\n", authorizeURL)
+	_ = OpenBrowser(authorizeURL)
+	return nil
+}
+
+// GetTokenContext gets a new access token context from the identity provider
+func (tr *BrowserRetriever) GetTokenContext() (*Context, error) {
+	return tr.GetTokenContextWithContext(context.Background())
+}
+
+// GetTokenContextWithContext gets a new access token context from the identity provider, binding requests to ctx
+func (tr *BrowserRetriever) GetTokenContextWithContext(ctx context.Context) (*Context, error) {
+	openURL := tr.OpenURL
+	if openURL == nil {
+		openURL = openInBrowser
+	}
+	tctx, err := tr.BrowserFlowWithContext(ctx, tr.ClientID, tr.RedirectURI, tr.Scope, openURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get token in browser flow")
+	}
+	return tctx, nil
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package idp
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// browser follows the redirect to the loopback listener that the IdP would send after the user logged in
+func browser(t *testing.T, authorizeURL string, query func(authorize url.Values) url.Values) *http.Response {
+	u, err := url.Parse(authorizeURL)
+	require.NoError(t, err)
+	redirect, err := url.Parse(u.Query().Get("redirect_uri"))
+	require.NoError(t, err)
+	redirect.RawQuery = query(u.Query()).Encode()
+	response, err := http.Get(redirect.String())
+	require.NoError(t, err)
+	response.Body.Close()
+	return response
+}
+
+func TestBrowserFlow(t *testing.T) {
+	var challenge string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "/token", r.URL.Path)
+		assert.Equal(t, "authorization_code", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "thecode", r.PostForm.Get("code"))
+		assert.True(t, strings.HasPrefix(r.PostForm.Get("redirect_uri"), "http://127.0.0.1:"))
+		sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		assert.Equal(t, challenge, base64.RawURLEncoding.EncodeToString(sum[:]))
+		_, _ = w.Write([]byte(`{"token_type":"Bearer","access_token":"token","expires_in":3600}`))
+	}))
+	defer server.Close()
+
+	tr := NewBrowserRetriever("client", "http://127.0.0.1/callback", "openid", server.URL, "", HostURLConfig{})
+	done := make(chan struct{})
+	tr.OpenURL = func(authorizeURL string) error {
+		assert.True(t, strings.HasPrefix(authorizeURL, server.URL+"/authorize?"))
+		go func() {
+			defer close(done)
+			// a redirect for another request is rejected
+			response := browser(t, authorizeURL, func(url.Values) url.Values {
+				return url.Values{"code": {"forged"}, "state": {"other"}}
+			})
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+			response = browser(t, authorizeURL, func(authorize url.Values) url.Values {
+				challenge = authorize.Get("code_challenge")
+				assert.Equal(t, "S256", authorize.Get("code_challenge_method"))
+				assert.Equal(t, "client", authorize.Get("client_id"))
+				redirect, err := url.Parse(authorize.Get("redirect_uri"))
+				require.NoError(t, err)
+				assert.Equal(t, "/callback", redirect.Path)
+				assert.NotEmpty(t, redirect.Port(), "a free port is chosen")
+				return url.Values{"code": {"thecode"}, "state": {authorize.Get("state")}}
+			})
+			assert.Equal(t, http.StatusOK, response.StatusCode)
+		}()
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	tctx, err := tr.GetTokenContextWithContext(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "token", tctx.AccessToken)
+	<-done
+}
+
+func TestBrowserFlowErrors(t *testing.T) {
+	client := NewClient("https://idp.example.com", "", "", "", "", "", "", "", false, HostURLConfig{})
+	_, err := client.BrowserFlow("client", "https://localhost/callback", "openid", func(string) error { return nil })
+	assert.Error(t, err, "only http loopback redirect uris can be listened on")
+	_, err = client.BrowserFlow("client", "http://example.com/callback", "openid", func(string) error { return nil })
+	assert.Error(t, err, "only http loopback redirect uris can be listened on")
+
+	// the user denies access
+	done := make(chan struct{})
+	_, err = client.BrowserFlow("client", "http://localhost", "openid", func(authorizeURL string) error {
+		go func() {
+			defer close(done)
+			browser(t, authorizeURL, func(authorize url.Values) url.Values {
+				return url.Values{"error": {"access_denied"}, "error_description": {"denied"}, "state": {authorize.Get("state")}}
+			})
+		}()
+		return nil
+	})
+	assert.EqualError(t, err, "authorization failed: access_denied: denied")
+	<-done
+
+	// the user never logs in
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = client.BrowserFlowWithContext(ctx, "client", "http://127.0.0.1:0/", "openid", func(string) error { return nil })
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
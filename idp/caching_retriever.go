@@ -0,0 +1,100 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package idp
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultCacheExpiryMargin is the default CachingRetriever.ExpiryMargin, longer than the window within which
+// service clients renew tokens
+const DefaultCacheExpiryMargin = 5 * time.Minute
+
+// CachingRetriever returns the token context persisted in a TokenStore until it nears expiry, retrieving and
+// storing a new one using another TokenRetriever otherwise, such that a process does not need to authenticate
+// each time it starts
+type CachingRetriever struct {
+	// Retriever retrieves new token contexts
+	Retriever TokenRetriever
+	// Store persists the token context
+	Store TokenStore
+	// ExpiryMargin is the time before a stored token expires from which it is no longer returned, defaults to
+	// DefaultCacheExpiryMargin
+	ExpiryMargin time.Duration
+
+	mux sync.Mutex
+	// returned is the access token last returned, which is not returned again
+	returned string
+	now      func() time.Time
+}
+
+// NewCachingRetriever returns a retriever caching the token contexts of inner in store, e.g. a FileTokenStore
+func NewCachingRetriever(inner TokenRetriever, store TokenStore) *CachingRetriever {
+	return &CachingRetriever{
+		Retriever:    inner,
+		Store:        store,
+		ExpiryMargin: DefaultCacheExpiryMargin,
+	}
+}
+
+// GetTokenContext returns the stored token context or gets a new one
+func (tr *CachingRetriever) GetTokenContext() (*Context, error) {
+	return tr.GetTokenContextWithContext(context.Background())
+}
+
+// GetTokenContextWithContext returns the stored token context unless it nears expiry or has already been returned,
+// since callers such as service clients only ask for another token once theirs is expiring or was rejected.
+// Otherwise a new token context is retrieved with the inner retriever, binding requests to ctx, and stored.
+// A store which cannot be read is treated as empty and errors saving to the store are ignored, such that the
+// cache never prevents authentication.
+func (tr *CachingRetriever) GetTokenContextWithContext(ctx context.Context) (*Context, error) {
+	tr.mux.Lock()
+	defer tr.mux.Unlock()
+	if tctx, err := tr.Store.Load(); err == nil && tr.valid(tctx) {
+		tr.returned = tctx.AccessToken
+		return tctx, nil
+	}
+	tctx, err := RetrieveTokenContext(ctx, tr.Retriever)
+	if err != nil {
+		return nil, err
+	}
+	if tctx.StartTime == 0 {
+		tctx.StartTime = tr.currentTime().Unix()
+	}
+	_ = tr.Store.Save(tctx)
+	tr.returned = tctx.AccessToken
+	return tctx, nil
+}
+
+// valid returns whether the stored tctx can be returned
+func (tr *CachingRetriever) valid(tctx *Context) bool {
+	if tctx == nil || tctx.AccessToken == "" || tctx.AccessToken == tr.returned {
+		return false
+	}
+	expiry := tctx.ExpiresAt()
+	return !expiry.IsZero() && tr.currentTime().Add(tr.ExpiryMargin).Before(expiry)
+}
+
+// currentTime returns the current time, which is injected in tests
+func (tr *CachingRetriever) currentTime() time.Time {
+	if tr.now != nil {
+		return tr.now()
+	}
+	return time.Now()
+}
@@ -0,0 +1,132 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package idp
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingRetriever returns a new access token on each call
+type countingRetriever struct {
+	calls int
+	err   error
+}
+
+func (tr *countingRetriever) GetTokenContext() (*Context, error) {
+	if tr.err != nil {
+		return nil, tr.err
+	}
+	tr.calls++
+	return &Context{AccessToken: fmt.Sprintf("token%d", tr.calls), ExpiresIn: 3600}, nil
+}
+
+func TestCachingRetriever(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	inner := &countingRetriever{}
+	store := NewMemoryTokenStore()
+	tr := NewCachingRetriever(inner, store)
+	tr.now = func() time.Time { return now }
+
+	// an empty store gets and stores a new token
+	tctx, err := tr.GetTokenContext()
+	require.NoError(t, err)
+	assert.Equal(t, "token1", tctx.AccessToken)
+	assert.Equal(t, now.Unix(), tctx.StartTime)
+	stored, err := store.Load()
+	require.NoError(t, err)
+	assert.Equal(t, tctx, stored)
+
+	// a new process returns the stored token
+	restarted := NewCachingRetriever(inner, store)
+	restarted.now = tr.now
+	tctx, err = restarted.GetTokenContext()
+	require.NoError(t, err)
+	assert.Equal(t, "token1", tctx.AccessToken)
+	assert.Equal(t, 1, inner.calls)
+
+	// a token which has been returned is not returned again, e.g. when it was rejected
+	tctx, err = restarted.GetTokenContext()
+	require.NoError(t, err)
+	assert.Equal(t, "token2", tctx.AccessToken)
+
+	// a token nearing expiry is replaced
+	now = now.Add(time.Hour - DefaultCacheExpiryMargin)
+	restarted = NewCachingRetriever(inner, store)
+	restarted.now = tr.now
+	tctx, err = restarted.GetTokenContext()
+	require.NoError(t, err)
+	assert.Equal(t, "token3", tctx.AccessToken)
+
+	// errors of the inner retriever are returned, the stored token is kept
+	inner.err = errors.New("idp down")
+	_, err = restarted.GetTokenContext()
+	assert.Equal(t, inner.err, err)
+	stored, err = store.Load()
+	require.NoError(t, err)
+	assert.Equal(t, "token3", stored.AccessToken)
+}
+
+func TestFileTokenStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tokens", "context.json")
+	store := NewFileTokenStore(path, "")
+	tctx, err := store.Load()
+	require.NoError(t, err)
+	assert.Nil(t, tctx)
+
+	saved := &Context{TokenType: "Bearer", AccessToken: "access", RefreshToken: "refresh", ExpiresIn: 3600, StartTime: 1700000000}
+	require.NoError(t, store.Save(saved))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	tctx, err = store.Load()
+	require.NoError(t, err)
+	assert.Equal(t, saved, tctx)
+
+	require.NoError(t, store.Delete())
+	require.NoError(t, store.Delete())
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestFileTokenStoreEncrypted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "context.json")
+	store := NewFileTokenStore(path, "passphrase")
+	saved := &Context{AccessToken: "access", RefreshToken: "refresh", ExpiresIn: 3600, StartTime: 1700000000}
+	require.NoError(t, store.Save(saved))
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.False(t, strings.Contains(string(data), "refresh"), "tokens must not be stored in clear text")
+
+	tctx, err := store.Load()
+	require.NoError(t, err)
+	assert.Equal(t, saved, tctx)
+
+	_, err = NewFileTokenStore(path, "wrong").Load()
+	assert.Error(t, err)
+	_, err = NewFileTokenStore(path, "").Load()
+	assert.Error(t, err)
+}
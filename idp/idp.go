@@ -17,6 +17,7 @@
 package idp
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/sha256"
 	"crypto/tls"
@@ -24,12 +25,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/splunk/splunk-cloud-sdk-go/util"
 )
 
 // Supported authentication flows
@@ -71,7 +74,7 @@ const (
 	// ScopeOffline - This scope value requests that an OAuth 2.0 Refresh Token be issued that can be used to obtain an Access Token that grants access to the End-User's UserInfo Endpoint even when the End-User is not present (not logged in).
 	ScopeOffline OIDCScope = "offline_access"
 	// RequestIDHeader uniquely identifies a request in the platform, found in response headers
-	RequestIDHeader string = "X-Request-Id"
+	RequestIDHeader string = util.RequestIDHeader
 )
 
 var (
@@ -102,19 +105,37 @@ func gets(m map[string]interface{}, key string) (string, error) {
 	return result, nil
 }
 
-// HTTPError Represents an error response
-type HTTPError struct {
-	StatusCode int                    `json:"status,omitempty"`
-	Body       map[string]interface{} `json:"body,omitempty"`
+// HTTPError Represents an error response, it is the same type returned by services so errors from either can
+// be handled alike, e.g. using errors.Is(err, util.ErrUnauthorized)
+type HTTPError = util.HTTPError
+
+// newHTTPError returns the error for an unsuccessful response from the IdP's operation endpoint, reading the
+// response body
+func newHTTPError(response *http.Response, operation string) *HTTPError {
+	body, _ := ioutil.ReadAll(response.Body)
+	return httpErrorFromBody(response, operation, body)
 }
 
-// Error handles marshalling of the HttpError to error type
-func (e *HTTPError) Error() string {
-	b, err := json.Marshal(e)
-	if err != nil {
-		return err.Error()
+// httpErrorFromBody returns the error for an unsuccessful response from the IdP's operation endpoint, body is
+// the response body which has already been read
+func httpErrorFromBody(response *http.Response, operation string, body []byte) *HTTPError {
+	httpErr := util.NewHTTPError(response, body)
+	httpErr.Service = "idp"
+	httpErr.Operation = operation
+	// OAuth errors are described using error and error_description rather than code and message
+	var oauthErr struct {
+		Error       string `json:"error"`
+		Description string `json:"error_description"`
+	}
+	if err := json.Unmarshal(body, &oauthErr); err == nil {
+		if httpErr.Code == "" {
+			httpErr.Code = oauthErr.Error
+		}
+		if httpErr.Message == "" {
+			httpErr.Message = oauthErr.Description
+		}
 	}
-	return string(b)
+	return httpErr
 }
 
 // Context Represents an authentication "context", which is the result of a
@@ -147,6 +168,15 @@ const (
 	defaultCsrfTokenPath       = "csrfToken"
 	defaultDevicePath          = "system/device"
 	defaultDevicePathTemplate  = "%s/device"
+	defaultRevokePath          = "revoke"
+	defaultTenantRevokePath    = "system/revoke"
+	defaultRevokePathTemplate  = "%s/revoke"
+)
+
+// Token type hints of Revoke
+const (
+	TokenTypeHintAccessToken  = "access_token"
+	TokenTypeHintRefreshToken = "refresh_token"
 )
 
 // Client captures url and route information for the IdP endpoints
@@ -159,8 +189,12 @@ type Client struct {
 	TenantTokenPath string
 	DevicePath      string
 	CsrfTokenPath   string
+	RevokePath      string
 	Insecure        bool
-	hostURLConfig   HostURLConfig
+	// Transport (optional) is used for requests to the IdP, e.g. one created by util.NewTransport to use a proxy
+	// or mutual TLS, Insecure is ignored if set
+	Transport     http.RoundTripper
+	hostURLConfig HostURLConfig
 }
 
 // NewClient Returns a new IdP client object.
@@ -210,15 +244,25 @@ func NewClient(providerURL string,
 		TenantTokenPath: tenantTokenPath,
 		CsrfTokenPath:   csrfTokenPath,
 		DevicePath:      devicePath,
+		RevokePath:      defaultRevokePath,
 		Insecure:        insecure,
 		hostURLConfig:   hostURLConfig,
 	}
 }
 
+// SetTransport sets the RoundTripper used for requests to the IdP
+func (c *Client) SetTransport(transport http.RoundTripper) {
+	c.Transport = transport
+}
+
 // Returns a new HTTP client object with redirects disabled.
-func newHTTPClient(insecure bool) *http.Client {
+func (c *Client) newHTTPClient() *http.Client {
+	transport := c.Transport
+	if transport == nil {
+		transport = &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: c.Insecure}}
+	}
 	return &http.Client{
-		Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: insecure}},
+		Transport: transport,
 		CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
 			return http.ErrUseLastResponse
 		}}
@@ -235,8 +279,8 @@ func decode(response *http.Response) (*Context, error) {
 	return context, nil
 }
 
-func newGet(reqURL string, params url.Values) (*http.Request, error) {
-	request, err := http.NewRequest("GET", reqURL, nil)
+func newGet(ctx context.Context, reqURL string, params url.Values) (*http.Request, error) {
+	request, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -245,9 +289,9 @@ func newGet(reqURL string, params url.Values) (*http.Request, error) {
 	return request, nil
 }
 
-func newFormPost(reqURL string, data url.Values) (*http.Request, error) {
+func newFormPost(ctx context.Context, reqURL string, data url.Values) (*http.Request, error) {
 	reader := strings.NewReader(data.Encode())
-	request, err := http.NewRequest("POST", reqURL, reader)
+	request, err := http.NewRequestWithContext(ctx, "POST", reqURL, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -256,8 +300,8 @@ func newFormPost(reqURL string, data url.Values) (*http.Request, error) {
 	return request, nil
 }
 
-func get(reqURL string, params url.Values, cookies []*http.Cookie, insecure bool) (*http.Response, error) {
-	request, err := newGet(reqURL, params)
+func get(ctx context.Context, client *http.Client, reqURL string, params url.Values, cookies []*http.Cookie) (*http.Response, error) {
+	request, err := newGet(ctx, reqURL, params)
 	if err != nil {
 		return nil, err
 	}
@@ -266,7 +310,7 @@ func get(reqURL string, params url.Values, cookies []*http.Cookie, insecure bool
 			request.AddCookie(cookie)
 		}
 	}
-	return newHTTPClient(insecure).Do(request)
+	return client.Do(request)
 }
 
 // Encode the given value and return its reader.
@@ -278,12 +322,12 @@ func encode(value interface{}) (*strings.Reader, error) {
 	return strings.NewReader(string(data)), nil
 }
 
-func newPost(reqURL string, body interface{}, cookies ...*http.Cookie) (*http.Request, error) {
+func newPost(ctx context.Context, reqURL string, body interface{}, cookies ...*http.Cookie) (*http.Request, error) {
 	reader, err := encode(body)
 	if err != nil {
 		return nil, err
 	}
-	request, err := http.NewRequest("POST", reqURL, reader)
+	request, err := http.NewRequestWithContext(ctx, "POST", reqURL, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -299,20 +343,32 @@ func newPost(reqURL string, body interface{}, cookies ...*http.Cookie) (*http.Re
 	return request, nil
 }
 
-func post(reqURL string, body interface{}, insecure bool, cookies ...*http.Cookie) (*http.Response, error) {
-	request, err := newPost(reqURL, body, cookies...)
+func post(ctx context.Context, client *http.Client, reqURL string, body interface{}, cookies ...*http.Cookie) (*http.Response, error) {
+	request, err := newPost(ctx, reqURL, body, cookies...)
 	if err != nil {
 		return nil, err
 	}
-	return newHTTPClient(insecure).Do(request)
+	return client.Do(request)
 }
 
-func formPost(reqURL string, data url.Values, insecure bool) (*http.Response, error) {
-	request, err := newFormPost(reqURL, data)
+func formPost(ctx context.Context, client *http.Client, reqURL string, data url.Values) (*http.Response, error) {
+	request, err := newFormPost(ctx, reqURL, data)
 	if err != nil {
 		return nil, err
 	}
-	return newHTTPClient(insecure).Do(request)
+	return client.Do(request)
+}
+
+// Waits for the given duration, returning early with the context's error if ctx is done first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 // Returns a synthetic state value.
@@ -386,63 +442,89 @@ func getRequestID(response *http.Response) string {
 
 // ClientFlow will authenticate using the "client credentials" flow.
 func (c *Client) ClientFlow(clientID, clientSecret, scope string) (*Context, error) {
+	return c.ClientFlowWithContext(context.Background(), clientID, clientSecret, scope)
+}
+
+// ClientFlowWithContext will authenticate using the "client credentials" flow, binding requests to ctx.
+func (c *Client) ClientFlowWithContext(ctx context.Context, clientID, clientSecret, scope string) (*Context, error) {
 	form := url.Values{
 		"grant_type": {"client_credentials"},
 		"scope":      {scope}}
 
-	var err error
-	hostURL := c.ProviderHost
+	tokenURL, err := c.clientTokenURL()
+	if err != nil {
+		return nil, err
+	}
+	request, err := newFormPost(ctx, tokenURL, form)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to create request to token endpoint url: %s", tokenURL))
+	}
+	request.SetBasicAuth(clientID, clientSecret)
+	return c.clientToken(request, tokenURL)
+}
 
+// scopedHostURL returns the url of the IdP host, tenant or region scoped according to the host url config
+func (c *Client) scopedHostURL() (string, error) {
 	if c.OverrideAuthURL != "" {
-		hostURL = c.OverrideAuthURL
+		return c.OverrideAuthURL, nil
+	}
+	if !c.hostURLConfig.TenantScoped {
+		return c.ProviderHost, nil
 	}
 	//Tenant scoped or region scoped hostname based on non-system or system tenant
+	if c.hostURLConfig.Tenant != "system" {
+		hostURL, err := getTenantScopedHost(c.hostURLConfig.TenantScoped, c.hostURLConfig.Tenant, c.ProviderHost)
+		if err != nil {
+			return "", errors.Wrap(err, "error in creating tenant scoped url")
+		}
+		return hostURL, nil
+	}
+	hostURL, err := getRegionScopedHost(c.hostURLConfig.TenantScoped, c.hostURLConfig.Region, c.ProviderHost)
+	if err != nil {
+		return "", errors.Wrap(err, "error in creating region scoped url")
+	}
+	return hostURL, nil
+}
+
+// clientTokenURL returns the token endpoint url of the client credentials and authorization code flows, tenant
+// or region scoped according to the host url config
+func (c *Client) clientTokenURL() (string, error) {
+	hostURL, err := c.scopedHostURL()
+	if err != nil {
+		return "", err
+	}
 	if c.hostURLConfig.TenantScoped && c.OverrideAuthURL == "" {
 		if c.hostURLConfig.Tenant != "system" {
-			hostURL, err = getTenantScopedHost(c.hostURLConfig.TenantScoped, c.hostURLConfig.Tenant, c.ProviderHost)
-			if err != nil {
-				return nil, errors.Wrap(err, "error in creating tenant scoped url")
-			}
 			c.TokenPath = fmt.Sprintf(defaultTenantTokenTemplate, c.hostURLConfig.Tenant)
-		} else if c.hostURLConfig.Tenant == "system" {
-			hostURL, err = getRegionScopedHost(c.hostURLConfig.TenantScoped, c.hostURLConfig.Region, c.ProviderHost)
-			if err != nil {
-				return nil, errors.Wrap(err, "error in creating region scoped url")
-			}
+		} else {
 			c.TokenPath = defaultTenantTokenPath
 		}
 	}
+	return c.makeURL(hostURL, c.TokenPath), nil
+}
 
-	tokenURL := c.makeURL(hostURL, c.TokenPath)
-	request, err := newFormPost(tokenURL, form)
-	if err != nil {
-		return nil, errors.Wrap(err, fmt.Sprintf("failed to create request to token endpoint url: %s", tokenURL))
-	}
-	request.SetBasicAuth(clientID, clientSecret)
-	response, err := newHTTPClient(c.Insecure).Do(request)
+// clientToken sends a token request of the client credentials and authorization code flows and decodes the
+// response
+func (c *Client) clientToken(request *http.Request, tokenURL string) (*Context, error) {
+	response, err := c.newHTTPClient().Do(request)
 	if err != nil {
 		return nil, errors.Wrap(err, fmt.Sprintf("failed to get response from token endpoint url: %s", tokenURL))
 	}
 	requestID := getRequestID(response)
 	defer response.Body.Close()
 	if response.StatusCode != http.StatusOK {
-		data, err := load(response.Body)
-		if err != nil {
-			return nil, errors.Wrap(err, fmt.Sprintf("failed to parse response body from token endpoint url: %s request id: %s", tokenURL, requestID))
-		}
-		msg := response.Status
-		if data != nil {
-			msg += "\nResponse body: \n"
-			for k, v := range data {
-				msg += fmt.Sprintf("%s:%s \n", k, v)
-			}
-		}
-		return nil, errors.Wrap(errors.New(msg), fmt.Sprintf("failed to get a successful response from token endpoint url: %s request id: %s", tokenURL, requestID))
+		return nil, errors.Wrap(newHTTPError(response, "token"), fmt.Sprintf("failed to get a successful response from token endpoint url: %s request id: %s", tokenURL, requestID))
 	}
 	return decode(response)
 }
 
+// GetCsrfToken returns a csrf token and the cookies which must accompany it in requests to the authn endpoint.
 func (c *Client) GetCsrfToken() (string, []*http.Cookie, error) {
+	return c.GetCsrfTokenWithContext(context.Background())
+}
+
+// GetCsrfTokenWithContext returns a csrf token and its accompanying cookies, binding the request to ctx.
+func (c *Client) GetCsrfTokenWithContext(ctx context.Context) (string, []*http.Cookie, error) {
 	var err error
 	hostURL := c.ProviderHost
 
@@ -458,7 +540,7 @@ func (c *Client) GetCsrfToken() (string, []*http.Cookie, error) {
 	}
 
 	tokenURL := c.makeURL(hostURL, c.CsrfTokenPath)
-	response, err := get(tokenURL, nil, nil, c.Insecure)
+	response, err := get(ctx, c.newHTTPClient(), tokenURL, nil, nil)
 	if err != nil {
 		return "", nil, errors.Wrap(err, fmt.Sprintf("failed to get valid response from csrfToken endpoint url: %s", tokenURL))
 	}
@@ -466,7 +548,7 @@ func (c *Client) GetCsrfToken() (string, []*http.Cookie, error) {
 	defer response.Body.Close()
 
 	if response.StatusCode != http.StatusOK {
-		return "", nil, errors.Wrap(errors.New(response.Status), fmt.Sprintf("unexpected status response from csrfToken endpoint url: %s request id: %s", tokenURL, requestID))
+		return "", nil, errors.Wrap(newHTTPError(response, "csrfToken"), fmt.Sprintf("unexpected status response from csrfToken endpoint url: %s request id: %s", tokenURL, requestID))
 	}
 
 	csrfTokenCookie := getCookie(response.Cookies(), "csrf")
@@ -480,7 +562,13 @@ func (c *Client) GetCsrfToken() (string, []*http.Cookie, error) {
 // GetSessionToken Returns a one-time session token by authenticating using a
 // "primary" endpoint (/authn).
 func (c *Client) GetSessionToken(username, password string) (string, []*http.Cookie, error) {
-	csrfToken, cookies, err := c.GetCsrfToken()
+	return c.GetSessionTokenWithContext(context.Background(), username, password)
+}
+
+// GetSessionTokenWithContext Returns a one-time session token by authenticating using a
+// "primary" endpoint (/authn), binding requests to ctx.
+func (c *Client) GetSessionTokenWithContext(ctx context.Context, username, password string) (string, []*http.Cookie, error) {
+	csrfToken, cookies, err := c.GetCsrfTokenWithContext(ctx)
 	if err != nil {
 		return "", nil, errors.Wrap(err, "failed to get valid response from csrfToken endpoint")
 	}
@@ -504,26 +592,19 @@ func (c *Client) GetSessionToken(username, password string) (string, []*http.Coo
 	}
 
 	authnURL := c.makeURL(hostURL, c.AuthnPath)
-	response, err := post(authnURL, body, c.Insecure, cookies...)
+	response, err := post(ctx, c.newHTTPClient(), authnURL, body, cookies...)
 	if err != nil {
 		return "", nil, errors.Wrap(err, fmt.Sprintf("failed to get valid response from authn endpoint url: %s", authnURL))
 	}
 	requestID := getRequestID(response)
 	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return "", nil, errors.Wrap(newHTTPError(response, "authn"), fmt.Sprintf("unexpected status response from authn endpoint url: %s request id: %s", authnURL, requestID))
+	}
 	data, err := load(response.Body)
 	if err != nil {
 		return "", nil, errors.Wrap(err, fmt.Sprintf("failed to parse response body from authn endpoint url: %s request id: %s", authnURL, requestID))
 	}
-	if response.StatusCode != http.StatusOK {
-		msg := response.Status
-		if data != nil {
-			msg += "\nResponse body: \n"
-			for k, v := range data {
-				msg += fmt.Sprintf("%s:%s \n", k, v)
-			}
-		}
-		return "", nil, errors.Wrap(errors.New(msg), fmt.Sprintf("unexpected status response from authn endpoint url: %s request id: %s", authnURL, requestID))
-	}
 	status, err := gets(data, "status")
 	if err != nil {
 		return "", nil, errors.Wrap(err, fmt.Sprintf("unable to get status data from authn endpoint url: %s request id: %s", authnURL, requestID))
@@ -573,8 +654,13 @@ func createCodeChallenge(n int) (string, string, error) {
 
 // PKCEFlow will authenticate using the "proof key for code exchange" flow.
 func (c *Client) PKCEFlow(clientID, redirectURI, scope, username, password string) (*Context, error) {
+	return c.PKCEFlowWithContext(context.Background(), clientID, redirectURI, scope, username, password)
+}
+
+// PKCEFlowWithContext will authenticate using the "proof key for code exchange" flow, binding requests to ctx.
+func (c *Client) PKCEFlowWithContext(ctx context.Context, clientID, redirectURI, scope, username, password string) (*Context, error) {
 	// retrieve one-time session token
-	sessionToken, sessionCookies, err := c.GetSessionToken(username, password)
+	sessionToken, sessionCookies, err := c.GetSessionTokenWithContext(ctx, username, password)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get session token")
 	}
@@ -618,13 +704,13 @@ func (c *Client) PKCEFlow(clientID, redirectURI, scope, username, password strin
 	}
 
 	authzURL := c.makeURL(hostURL, c.AuthorizePath)
-	response, err := get(authzURL, params, sessionCookies, c.Insecure)
+	response, err := get(ctx, c.newHTTPClient(), authzURL, params, sessionCookies)
 	if err != nil {
 		return nil, errors.Wrap(err, fmt.Sprintf("failed to get valid response from authorize endpoint url: %s", authzURL))
 	}
 	requestID := getRequestID(response)
 	if response.StatusCode != http.StatusFound {
-		return nil, errors.Wrap(errors.New(fmt.Sprintf("%v", response.StatusCode)), fmt.Sprintf("failed to get successful response from authorize endpoint url: %s request id: %s", authzURL, requestID))
+		return nil, errors.Wrap(newHTTPError(response, "authorize"), fmt.Sprintf("failed to get successful response from authorize endpoint url: %s request id: %s", authzURL, requestID))
 	}
 
 	// retrieve the authorization code from the redirect url query string
@@ -669,32 +755,25 @@ func (c *Client) PKCEFlow(clientID, redirectURI, scope, username, password strin
 	}
 
 	tokenURL := c.makeURL(hostURL, c.TokenPath)
-	response, err = formPost(tokenURL, form, c.Insecure)
+	response, err = formPost(ctx, c.newHTTPClient(), tokenURL, form)
 	if err != nil {
 		return nil, errors.Wrap(err, fmt.Sprintf("failed to get valid response from token endpoint url: %s", tokenURL))
 	}
 	requestID = getRequestID(response)
 	defer response.Body.Close()
 	if response.StatusCode != http.StatusOK {
-		data, err := load(response.Body)
-		if err != nil {
-			return nil, errors.Wrap(err, fmt.Sprintf("failed to parse response body from token endpoint url: %s request id: %s", tokenURL, requestID))
-		}
-		msg := response.Status
-		if data != nil {
-			msg += "\nResponse body: \n"
-			for k, v := range data {
-				msg += fmt.Sprintf("%s:%s \n", k, v)
-			}
-		}
-		return nil, errors.Wrap(errors.New(msg), fmt.Sprintf("failed to get a successful response from token endpoint url: %s request id: %s", tokenURL, requestID))
-
+		return nil, errors.Wrap(newHTTPError(response, "token"), fmt.Sprintf("failed to get a successful response from token endpoint url: %s request id: %s", tokenURL, requestID))
 	}
 	return decode(response)
 }
 
 // Refresh will authenticate using a refresh token.
 func (c *Client) Refresh(clientID, scope, refreshToken string) (*Context, error) {
+	return c.RefreshWithContext(context.Background(), clientID, scope, refreshToken)
+}
+
+// RefreshWithContext will authenticate using a refresh token, binding the request to ctx.
+func (c *Client) RefreshWithContext(ctx context.Context, clientID, scope, refreshToken string) (*Context, error) {
 	form := url.Values{
 		"client_id":     {clientID},
 		"grant_type":    {"refresh_token"},
@@ -728,20 +807,65 @@ func (c *Client) Refresh(clientID, scope, refreshToken string) (*Context, error)
 		c.TokenPath = defaultTenantTokenPath
 	}
 	tokenURL := c.makeURL(hostURL, c.TokenPath)
-	response, err := formPost(tokenURL, form, c.Insecure)
+	response, err := formPost(ctx, c.newHTTPClient(), tokenURL, form)
 
 	if err != nil {
 		return nil, errors.Wrap(err, fmt.Sprintf("failed to get valid response from token endpoint url: %s", tokenURL))
 	}
 	requestID := getRequestID(response)
 	if response.StatusCode != http.StatusOK {
-		return nil, errors.Wrap(errors.New(fmt.Sprintf("%v", response.StatusCode)), fmt.Sprintf("failed to get successful response from token endpoint url: %s request id: %s", tokenURL, requestID))
+		return nil, errors.Wrap(newHTTPError(response, "token"), fmt.Sprintf("failed to get successful response from token endpoint url: %s request id: %s", tokenURL, requestID))
 	}
 	return decode(response)
 }
 
+// Revoke revokes a refresh or access token (RFC 7009), tokenTypeHint is TokenTypeHintRefreshToken,
+// TokenTypeHintAccessToken or "" if unknown.
+func (c *Client) Revoke(clientID, token, tokenTypeHint string) error {
+	return c.RevokeWithContext(context.Background(), clientID, token, tokenTypeHint)
+}
+
+// RevokeWithContext revokes a refresh or access token, binding the request to ctx. Revoking a refresh token also
+// invalidates the access tokens issued with it. Tokens which are invalid or already revoked are not an error.
+func (c *Client) RevokeWithContext(ctx context.Context, clientID, token, tokenTypeHint string) error {
+	form := url.Values{
+		"client_id": {clientID},
+		"token":     {token}}
+	if tokenTypeHint != "" {
+		form.Set("token_type_hint", tokenTypeHint)
+	}
+	hostURL, err := c.scopedHostURL()
+	if err != nil {
+		return err
+	}
+	// tenant/revoke path if tenant scoped to a non-system tenant, else system/revoke
+	if c.hostURLConfig.TenantScoped && c.OverrideAuthURL == "" {
+		if c.hostURLConfig.Tenant != "system" {
+			c.RevokePath = fmt.Sprintf(defaultRevokePathTemplate, c.hostURLConfig.Tenant)
+		} else {
+			c.RevokePath = defaultTenantRevokePath
+		}
+	}
+	revokeURL := c.makeURL(hostURL, c.RevokePath)
+	response, err := formPost(ctx, c.newHTTPClient(), revokeURL, form)
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("failed to get valid response from revoke endpoint url: %s", revokeURL))
+	}
+	defer response.Body.Close()
+	requestID := getRequestID(response)
+	if response.StatusCode != http.StatusOK {
+		return errors.Wrap(newHTTPError(response, "revoke"), fmt.Sprintf("failed to get successful response from revoke endpoint url: %s request id: %s", revokeURL, requestID))
+	}
+	return nil
+}
+
 // GetDeviceCodes will get info for the device flow.
 func (c *Client) GetDeviceCodes(clientID, scope string) (*DeviceCodeInfo, error) {
+	return c.GetDeviceCodesWithContext(context.Background(), clientID, scope)
+}
+
+// GetDeviceCodesWithContext will get info for the device flow, binding the request to ctx.
+func (c *Client) GetDeviceCodesWithContext(ctx context.Context, clientID, scope string) (*DeviceCodeInfo, error) {
 	form := url.Values{
 		"client_id": {clientID},
 		"scope":     {scope}}
@@ -775,13 +899,13 @@ func (c *Client) GetDeviceCodes(clientID, scope string) (*DeviceCodeInfo, error)
 	}
 
 	deviceURL := c.makeURL(hostURL, c.DevicePath)
-	response, err := formPost(deviceURL, form, c.Insecure)
+	response, err := formPost(ctx, c.newHTTPClient(), deviceURL, form)
 	if err != nil {
 		return nil, errors.Wrap(err, fmt.Sprintf("failed to get valid response from device endpoint url: %s", deviceURL))
 	}
 	requestID := getRequestID(response)
 	if response.StatusCode != http.StatusOK {
-		return nil, errors.Wrap(errors.New(fmt.Sprintf("%v", response.StatusCode)), fmt.Sprintf("failed to get successful response from device endpoint url: %s request id: %s", deviceURL, requestID))
+		return nil, errors.Wrap(newHTTPError(response, "device"), fmt.Sprintf("failed to get successful response from device endpoint url: %s request id: %s", deviceURL, requestID))
 	}
 
 	var info DeviceCodeInfo
@@ -793,6 +917,11 @@ func (c *Client) GetDeviceCodes(clientID, scope string) (*DeviceCodeInfo, error)
 
 // DeviceFlow will authenticate using the device flow.
 func (c *Client) DeviceFlow(clientID, deviceCode string, expiresIn, interval int) (*Context, error) {
+	return c.DeviceFlowWithContext(context.Background(), clientID, deviceCode, expiresIn, interval)
+}
+
+// DeviceFlowWithContext will authenticate using the device flow, polling until the code expires or ctx is done.
+func (c *Client) DeviceFlowWithContext(ctx context.Context, clientID, deviceCode string, expiresIn, interval int) (*Context, error) {
 	var response *http.Response
 	codeExpiration := time.Now().Add(time.Duration(expiresIn) * time.Second)
 	pollingInterval := time.Duration(interval) * time.Second
@@ -830,35 +959,43 @@ func (c *Client) DeviceFlow(clientID, deviceCode string, expiresIn, interval int
 	}
 	for time.Now().Before(codeExpiration) {
 		tokenURL := c.makeURL(hostURL, c.TenantTokenPath)
-		response, err = formPost(tokenURL, form, c.Insecure)
+		response, err = formPost(ctx, c.newHTTPClient(), tokenURL, form)
 		if err != nil {
 			return nil, errors.Wrap(err, fmt.Sprintf("failed to get valid response from tenant token endpoint url: %s", tokenURL))
 		}
 		requestID := getRequestID(response)
 		if response.StatusCode == http.StatusBadRequest {
 			defer response.Body.Close()
-			data, err := load(response.Body)
+			body, err := ioutil.ReadAll(response.Body)
 			if err != nil {
+				return nil, errors.Wrap(err, fmt.Sprintf("failed to read response body from tenant token endpoint url: %s request id: %s", tokenURL, requestID))
+			}
+			var data map[string]interface{}
+			if err := json.Unmarshal(body, &data); err != nil {
 				return nil, errors.Wrap(err, fmt.Sprintf("failed to parse response body from tenant token endpoint url: %s request id: %s", tokenURL, requestID))
 			}
 			switch data["error_description"] {
 			case "authorization_pending":
-				time.Sleep(pollingInterval)
+				if err := sleep(ctx, pollingInterval); err != nil {
+					return nil, err
+				}
 				continue
 			case "slow_down":
 				// add 5 seconds to polling interval on "slow_down" error
 				pollingInterval += 5
-				time.Sleep(pollingInterval)
+				if err := sleep(ctx, pollingInterval); err != nil {
+					return nil, err
+				}
 				continue
 			case "expired_token":
-				return nil, errors.Wrap(errors.New(fmt.Sprintf("%v", response.StatusCode)), fmt.Sprintf("code expired %s url: request id: %s", tokenURL, requestID))
+				return nil, errors.Wrap(httpErrorFromBody(response, "token", body), fmt.Sprintf("code expired %s url: request id: %s", tokenURL, requestID))
 			case "access_denied":
-				return nil, errors.Wrap(errors.New(fmt.Sprintf("%v", response.StatusCode)), fmt.Sprintf("access denied %s url: request id: %s", tokenURL, requestID))
+				return nil, errors.Wrap(httpErrorFromBody(response, "token", body), fmt.Sprintf("access denied %s url: request id: %s", tokenURL, requestID))
 			default:
-				return nil, errors.Wrap(errors.New(fmt.Sprintf("%v", response.StatusCode)), fmt.Sprintf("failed to get successful response from tenant token endpoint %s url: request id: %s", tokenURL, requestID))
+				return nil, errors.Wrap(httpErrorFromBody(response, "token", body), fmt.Sprintf("failed to get successful response from tenant token endpoint %s url: request id: %s", tokenURL, requestID))
 			}
 		} else if response.StatusCode != http.StatusOK {
-			return nil, errors.Wrap(errors.New(fmt.Sprintf("%v", response.StatusCode)), fmt.Sprintf("failed to get successful response from tenant token endpoint %s url: request id: %s", tokenURL, requestID))
+			return nil, errors.Wrap(newHTTPError(response, "token"), fmt.Sprintf("failed to get successful response from tenant token endpoint %s url: request id: %s", tokenURL, requestID))
 		} else {
 			// return decoded response if response.StatusCode = 200
 			return decode(response)
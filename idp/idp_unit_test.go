@@ -18,12 +18,16 @@ package idp
 
 import (
 	"bytes"
+	"context"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"errors"
 	"fmt"
 
+	"github.com/splunk/splunk-cloud-sdk-go/util"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -111,3 +115,82 @@ func TestGetRegionScopedHost(t *testing.T) {
 	fmt.Println(expectedURL)
 	require.Equal(t, hostURL+tokenPath, expectedURL)
 }
+
+type staticRetriever struct {
+	calls int
+}
+
+func (tr *staticRetriever) GetTokenContext() (*Context, error) {
+	tr.calls++
+	return &Context{AccessToken: "static.token"}, nil
+}
+
+func TestRetrieveTokenContext(t *testing.T) {
+	noop := &NoOpTokenRetriever{Context: &Context{AccessToken: "noop.token"}}
+	ctx, err := RetrieveTokenContext(context.Background(), noop)
+	require.NoError(t, err)
+	assert.Equal(t, "noop.token", ctx.AccessToken)
+
+	// Retrievers that do not implement ContextTokenRetriever are only called if ctx is not done
+	tr := &staticRetriever{}
+	ctx, err = RetrieveTokenContext(context.Background(), tr)
+	require.NoError(t, err)
+	assert.Equal(t, "static.token", ctx.AccessToken)
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = RetrieveTokenContext(canceled, tr)
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 1, tr.calls)
+}
+
+func TestClientFlowWithContextCanceled(t *testing.T) {
+	client := NewClient("https://myhost.net/", "", "", "", "", "", "", "", false, HostURLConfig{})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := client.ClientFlowWithContext(ctx, "clientid", "secret", "scope")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, context.Canceled))
+}
+
+func TestClientFlowHTTPError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(RequestIDHeader, "req-1234")
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error":"invalid_client","error_description":"client authentication failed"}`))
+	}))
+	defer server.Close()
+	client := NewClient(server.URL+"/", "", "", "", "", "", "", "", false, HostURLConfig{})
+	_, err := client.ClientFlow("clientid", "secret", "scope")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, util.ErrUnauthorized))
+	var httpErr *HTTPError
+	require.True(t, errors.As(err, &httpErr))
+	assert.Equal(t, http.StatusUnauthorized, httpErr.HTTPStatusCode)
+	assert.Equal(t, "idp", httpErr.Service)
+	assert.Equal(t, "token", httpErr.Operation)
+	assert.Equal(t, "req-1234", httpErr.RequestID)
+	assert.Equal(t, "invalid_client", httpErr.Code)
+	assert.Equal(t, "client authentication failed", httpErr.Message)
+}
+
+func TestRevoke(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "/revoke", r.URL.Path)
+		assert.Equal(t, "clientid", r.PostForm.Get("client_id"))
+		assert.Equal(t, TokenTypeHintRefreshToken, r.PostForm.Get("token_type_hint"))
+		if r.PostForm.Get("token") != "my.refresh.token" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_request","error_description":"bad token"}`))
+		}
+	}))
+	defer server.Close()
+	client := NewClient(server.URL+"/", "", "", "", "", "", "", "", false, HostURLConfig{})
+	require.NoError(t, client.Revoke("clientid", "my.refresh.token", TokenTypeHintRefreshToken))
+
+	err := client.Revoke("clientid", "other", TokenTypeHintRefreshToken)
+	var httpErr *HTTPError
+	require.True(t, errors.As(err, &httpErr))
+	assert.Equal(t, "revoke", httpErr.Operation)
+	assert.Equal(t, "invalid_request", httpErr.Code)
+}
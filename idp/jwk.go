@@ -0,0 +1,218 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package idp
+
+import (
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+// JWK is a JSON Web Key (RFC 7517), such as the EC public keys registered on principals with the identity
+// service's AddPrincipalPublicKey, which takes the same fields as identity.EcJwk, or the keys of the IdP which
+// sign tokens
+type JWK struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+	// D is the private key of EC keys, never set for public keys
+	D string `json:"d,omitempty"`
+	// N is the modulus of RSA keys
+	N string `json:"n,omitempty"`
+	// E is the exponent of RSA keys
+	E   string `json:"e,omitempty"`
+	Kid string `json:"kid,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	Use string `json:"use,omitempty"`
+}
+
+// ecCurve describes a curve supported for EC keys
+type ecCurve struct {
+	crv   string
+	alg   string
+	size  int
+	curve elliptic.Curve
+	ecdh  ecdh.Curve
+}
+
+// ecCurves are the curves supported for EC keys, by JWK crv
+var ecCurves = map[string]ecCurve{
+	"P-256": {crv: "P-256", alg: "ES256", size: 32, curve: elliptic.P256(), ecdh: ecdh.P256()},
+	"P-384": {crv: "P-384", alg: "ES384", size: 48, curve: elliptic.P384(), ecdh: ecdh.P384()},
+	"P-521": {crv: "P-521", alg: "ES512", size: 66, curve: elliptic.P521(), ecdh: ecdh.P521()},
+}
+
+// curveOf returns the supported curve of an EC key
+func curveOf(pub *ecdsa.PublicKey) (ecCurve, error) {
+	if pub == nil || pub.Curve == nil {
+		return ecCurve{}, errors.New("missing EC key")
+	}
+	c, ok := ecCurves[pub.Curve.Params().Name]
+	if !ok {
+		return ecCurve{}, fmt.Errorf("unsupported EC curve: %s", pub.Curve.Params().Name)
+	}
+	return c, nil
+}
+
+// ParseJWK parses a JSON Web Key
+func ParseJWK(data []byte) (*JWK, error) {
+	var jwk JWK
+	if err := json.Unmarshal(data, &jwk); err != nil {
+		return nil, errors.Wrap(err, "failed to parse JWK")
+	}
+	if jwk.Kty == "" {
+		return nil, errors.New("failed to parse JWK: missing kty")
+	}
+	return &jwk, nil
+}
+
+// NewECJWK returns the public JWK of an EC key, with kid and alg unset
+func NewECJWK(pub *ecdsa.PublicKey) (*JWK, error) {
+	c, err := curveOf(pub)
+	if err != nil {
+		return nil, err
+	}
+	key, err := pub.ECDH()
+	if err != nil {
+		return nil, err
+	}
+	// the uncompressed point: 0x04 || x || y
+	point := key.Bytes()
+	return &JWK{
+		Kty: "EC",
+		Crv: c.crv,
+		X:   base64.RawURLEncoding.EncodeToString(point[1 : 1+c.size]),
+		Y:   base64.RawURLEncoding.EncodeToString(point[1+c.size:]),
+	}, nil
+}
+
+// ECPublicKey returns the EC public key of the JWK
+func (k *JWK) ECPublicKey() (*ecdsa.PublicKey, error) {
+	c, point, err := k.ecPoint()
+	if err != nil {
+		return nil, err
+	}
+	key, err := c.ecdh.NewPublicKey(point)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid EC JWK")
+	}
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, err
+	}
+	return pub.(*ecdsa.PublicKey), nil
+}
+
+// RSAPublicKey returns the RSA public key of the JWK
+func (k *JWK) RSAPublicKey() (*rsa.PublicKey, error) {
+	if k.Kty != "RSA" {
+		return nil, fmt.Errorf("unsupported JWK kty: %s", k.Kty)
+	}
+	n, errN := base64.RawURLEncoding.DecodeString(k.N)
+	e, errE := base64.RawURLEncoding.DecodeString(k.E)
+	if errN != nil || errE != nil || len(n) == 0 || len(e) == 0 || len(e) > 4 {
+		return nil, errors.New("invalid RSA JWK: malformed n or e")
+	}
+	exponent := 0
+	for _, b := range e {
+		exponent = exponent<<8 | int(b)
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: exponent}, nil
+}
+
+// ECPrivateKey returns the EC private key of the JWK, which must include d
+func (k *JWK) ECPrivateKey() (*ecdsa.PrivateKey, error) {
+	c, point, err := k.ecPoint()
+	if err != nil {
+		return nil, err
+	}
+	if k.D == "" {
+		return nil, errors.New("EC JWK is not a private key: missing d")
+	}
+	d, err := base64.RawURLEncoding.DecodeString(k.D)
+	if err != nil || len(d) != c.size {
+		return nil, errors.New("invalid EC JWK: malformed d")
+	}
+	key, err := c.ecdh.NewPrivateKey(d)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid EC JWK")
+	}
+	if string(key.PublicKey().Bytes()) != string(point) {
+		return nil, errors.New("invalid EC JWK: x and y do not match d")
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	priv, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, err
+	}
+	return priv.(*ecdsa.PrivateKey), nil
+}
+
+// ecPoint returns the curve and uncompressed point of an EC JWK
+func (k *JWK) ecPoint() (ecCurve, []byte, error) {
+	if k.Kty != "EC" {
+		return ecCurve{}, nil, fmt.Errorf("unsupported JWK kty: %s", k.Kty)
+	}
+	c, ok := ecCurves[k.Crv]
+	if !ok {
+		return ecCurve{}, nil, fmt.Errorf("unsupported EC JWK crv: %s", k.Crv)
+	}
+	x, errX := base64.RawURLEncoding.DecodeString(k.X)
+	y, errY := base64.RawURLEncoding.DecodeString(k.Y)
+	if errX != nil || errY != nil || len(x) != c.size || len(y) != c.size {
+		return ecCurve{}, nil, errors.New("invalid EC JWK: malformed x or y")
+	}
+	point := append([]byte{4}, x...)
+	return c, append(point, y...), nil
+}
+
+// Thumbprint returns the JWK thumbprint of the public key (RFC 7638), base64url encoded, which is used as the
+// kid of keys which have none
+func (k *JWK) Thumbprint() (string, error) {
+	if _, _, err := k.ecPoint(); err != nil {
+		return "", err
+	}
+	// the required members in lexicographic order, without whitespace
+	members, err := json.Marshal(struct {
+		Crv string `json:"crv"`
+		Kty string `json:"kty"`
+		X   string `json:"x"`
+		Y   string `json:"y"`
+	}{k.Crv, k.Kty, k.X, k.Y})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(members)
+	return base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,173 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package idp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultKeySetCacheDuration is how long the keys of a KeySet are cached by default
+	DefaultKeySetCacheDuration = time.Hour
+	// keySetRefetchInterval limits how often keys are refetched for tokens signed by an unknown key, e.g. forged ones
+	keySetRefetchInterval = time.Minute
+	// discoveryPath is the path of the OpenID Connect discovery document, relative to the IdP host
+	discoveryPath = ".well-known/openid-configuration"
+)
+
+// KeySet is the JSON Web Key Set of the IdP, the public keys which sign its tokens, fetched on first use and
+// cached. The keys are refetched once the cache expires, or when a token is signed by an unknown key as the IdP
+// rotates its keys.
+type KeySet struct {
+	// URL of the JWKS, if empty it is read from the jwks_uri of the discovery document at DiscoveryURL
+	URL string
+	// DiscoveryURL is the url of the IdP's OpenID Connect discovery document
+	DiscoveryURL string
+	// CacheDuration is how long keys are cached, DefaultKeySetCacheDuration if 0
+	CacheDuration time.Duration
+	// HTTPClient (optional) is used to fetch the keys, http.DefaultClient if nil
+	HTTPClient *http.Client
+
+	mux     sync.Mutex
+	keys    []JWK
+	fetched time.Time
+	now     func() time.Time
+}
+
+// NewKeySet returns a key set fetched from jwksURL
+func NewKeySet(jwksURL string) *KeySet {
+	return &KeySet{URL: jwksURL}
+}
+
+// KeySet returns the key set of the IdP, which is discovered from its OpenID Connect discovery document and
+// fetched using the client's transport
+func (c *Client) KeySet() (*KeySet, error) {
+	hostURL, err := c.scopedHostURL()
+	if err != nil {
+		return nil, err
+	}
+	return &KeySet{DiscoveryURL: c.makeURL(hostURL, discoveryPath), HTTPClient: c.newHTTPClient()}, nil
+}
+
+// Key returns the key with the given kid, or the only key if kid is empty, fetching the keys if they are not
+// cached
+func (ks *KeySet) Key(ctx context.Context, kid string) (*JWK, error) {
+	ks.mux.Lock()
+	defer ks.mux.Unlock()
+	now := ks.currentTime()
+	cacheDuration := ks.CacheDuration
+	if cacheDuration == 0 {
+		cacheDuration = DefaultKeySetCacheDuration
+	}
+	if ks.keys == nil || now.Sub(ks.fetched) >= cacheDuration {
+		if err := ks.fetch(ctx); err != nil {
+			return nil, err
+		}
+	}
+	key := ks.find(kid)
+	if key == nil && now.Sub(ks.fetched) >= keySetRefetchInterval {
+		if err := ks.fetch(ctx); err != nil {
+			return nil, err
+		}
+		key = ks.find(kid)
+	}
+	if key == nil {
+		return nil, errors.Wrap(ErrInvalidSignature, fmt.Sprintf("no key found in the key set for kid: %s", kid))
+	}
+	return key, nil
+}
+
+// find returns the key with the given kid, or the only key if kid is empty
+func (ks *KeySet) find(kid string) *JWK {
+	if kid == "" {
+		if len(ks.keys) == 1 {
+			return &ks.keys[0]
+		}
+		return nil
+	}
+	for i := range ks.keys {
+		if ks.keys[i].Kid == kid {
+			return &ks.keys[i]
+		}
+	}
+	return nil
+}
+
+// fetch fetches the keys, discovering the url of the key set first if it is not known
+func (ks *KeySet) fetch(ctx context.Context) error {
+	if ks.URL == "" {
+		if ks.DiscoveryURL == "" {
+			return errors.New("failed to fetch key set: no url")
+		}
+		var discovery struct {
+			JWKSURI string `json:"jwks_uri"`
+		}
+		if err := ks.getJSON(ctx, ks.DiscoveryURL, "discovery", &discovery); err != nil {
+			return err
+		}
+		if discovery.JWKSURI == "" {
+			return fmt.Errorf("failed to discover key set: no jwks_uri in: %s", ks.DiscoveryURL)
+		}
+		ks.URL = discovery.JWKSURI
+	}
+	var set struct {
+		Keys []JWK `json:"keys"`
+	}
+	if err := ks.getJSON(ctx, ks.URL, "jwks", &set); err != nil {
+		return err
+	}
+	if set.Keys == nil {
+		set.Keys = []JWK{}
+	}
+	ks.keys = set.Keys
+	ks.fetched = ks.currentTime()
+	return nil
+}
+
+// getJSON gets the json document at reqURL from the IdP's operation endpoint and decodes it into v
+func (ks *KeySet) getJSON(ctx context.Context, reqURL string, operation string, v interface{}) error {
+	client := ks.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	response, err := get(ctx, client, reqURL, nil, nil)
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("failed to get response from %s endpoint url: %s", operation, reqURL))
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return errors.Wrap(newHTTPError(response, operation), fmt.Sprintf("failed to get a successful response from %s endpoint url: %s", operation, reqURL))
+	}
+	if err := json.NewDecoder(response.Body).Decode(v); err != nil {
+		return errors.Wrap(err, fmt.Sprintf("failed to decode response from %s endpoint url: %s", operation, reqURL))
+	}
+	return nil
+}
+
+func (ks *KeySet) currentTime() time.Time {
+	if ks.now != nil {
+		return ks.now()
+	}
+	return time.Now()
+}
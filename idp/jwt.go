@@ -0,0 +1,310 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package idp
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	_ "crypto/sha256" // register the hashes of the supported algorithms
+	_ "crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrTokenExpired is returned by VerifyJWT for tokens past their exp claim
+	ErrTokenExpired = errors.New("token is expired")
+	// ErrTokenNotYetValid is returned by VerifyJWT for tokens before their nbf claim
+	ErrTokenNotYetValid = errors.New("token is not valid yet")
+	// ErrInvalidSignature is returned by VerifyJWT for tokens not signed by a key of the key set
+	ErrInvalidSignature = errors.New("token signature is invalid")
+)
+
+// JWTHeader is the JOSE header of a JWT
+type JWTHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid,omitempty"`
+	Typ string `json:"typ,omitempty"`
+}
+
+// Claims are the claims of an access or ID token issued by the IdP
+type Claims struct {
+	// Issuer (iss) is the url of the IdP which issued the token
+	Issuer string
+	// Subject (sub) is the principal the token was issued to
+	Subject string
+	// Audience (aud) are the recipients the token is intended for
+	Audience []string
+	// ExpiresAt (exp) is when the token expires, in seconds since the epoch
+	ExpiresAt int64
+	// IssuedAt (iat) is when the token was issued, in seconds since the epoch
+	IssuedAt int64
+	// NotBefore (nbf) is when the token becomes valid, in seconds since the epoch
+	NotBefore int64
+	// ID (jti) uniquely identifies the token
+	ID string
+	// ClientID (cid, client_id or azp) is the app the token was issued to
+	ClientID string
+	// Tenant (tenant) is the tenant the token is scoped to, if any
+	Tenant string
+	// Scopes (scp or scope) are the scopes granted to the token
+	Scopes []string
+	// Email (email) of the principal, included in ID tokens if the email scope was requested
+	Email string
+	// Raw are all of the claims as decoded from JSON
+	Raw map[string]interface{}
+}
+
+// UnmarshalJSON decodes the claims, accepting either a string or an array for aud and scp
+func (c *Claims) UnmarshalJSON(data []byte) error {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*c = Claims{
+		Issuer:    stringClaim(raw, "iss"),
+		Subject:   stringClaim(raw, "sub"),
+		Audience:  stringsClaim(raw, "aud"),
+		ExpiresAt: numericClaim(raw, "exp"),
+		IssuedAt:  numericClaim(raw, "iat"),
+		NotBefore: numericClaim(raw, "nbf"),
+		ID:        stringClaim(raw, "jti"),
+		Tenant:    stringClaim(raw, "tenant"),
+		Email:     stringClaim(raw, "email"),
+		Raw:       raw,
+	}
+	for _, name := range []string{"cid", "client_id", "azp"} {
+		if c.ClientID = stringClaim(raw, name); c.ClientID != "" {
+			break
+		}
+	}
+	if c.Scopes = stringsClaim(raw, "scp"); c.Scopes == nil {
+		c.Scopes = stringsClaim(raw, "scope")
+	}
+	return nil
+}
+
+func stringClaim(raw map[string]interface{}, name string) string {
+	s, _ := raw[name].(string)
+	return s
+}
+
+func numericClaim(raw map[string]interface{}, name string) int64 {
+	n, _ := raw[name].(float64)
+	return int64(n)
+}
+
+// stringsClaim returns a claim which is an array of strings or a space separated string
+func stringsClaim(raw map[string]interface{}, name string) []string {
+	switch v := raw[name].(type) {
+	case string:
+		return strings.Fields(v)
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, value := range v {
+			if s, ok := value.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+// Expiry returns when the token expires, the zero time if it has no exp claim
+func (c *Claims) Expiry() time.Time {
+	if c.ExpiresAt == 0 {
+		return time.Time{}
+	}
+	return time.Unix(c.ExpiresAt, 0)
+}
+
+// HasScope returns whether scope was granted to the token
+func (c *Claims) HasScope(scope string) bool {
+	for _, s := range c.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// JWT is a parsed JSON Web Token, such as the access and ID tokens of a Context
+type JWT struct {
+	// Raw is the encoded token
+	Raw    string
+	Header JWTHeader
+	Claims Claims
+
+	signingInput string
+	signature    []byte
+}
+
+// ParseJWT parses a token in JWS compact serialization without verifying it, see VerifyJWT
+func ParseJWT(token string) (*JWT, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("failed to parse JWT: malformed token")
+	}
+	jwt := &JWT{Raw: token, signingInput: parts[0] + "." + parts[1]}
+	if err := decodeSegment(parts[0], &jwt.Header); err != nil {
+		return nil, errors.Wrap(err, "failed to parse JWT header")
+	}
+	if err := decodeSegment(parts[1], &jwt.Claims); err != nil {
+		return nil, errors.Wrap(err, "failed to parse JWT claims")
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse JWT signature")
+	}
+	jwt.signature = signature
+	return jwt, nil
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// VerifyJWT parses token and verifies that it is signed by a key of keys and that it is valid at the current
+// time according to its exp and nbf claims. The issuer and audience are not checked, see Claims.
+func VerifyJWT(ctx context.Context, token string, keys *KeySet) (*JWT, error) {
+	jwt, err := ParseJWT(token)
+	if err != nil {
+		return nil, err
+	}
+	if err := jwt.Verify(ctx, keys); err != nil {
+		return nil, err
+	}
+	return jwt, nil
+}
+
+// Verify verifies that the token is signed by a key of keys and that it is valid at the current time according
+// to its exp and nbf claims
+func (t *JWT) Verify(ctx context.Context, keys *KeySet) error {
+	key, err := keys.Key(ctx, t.Header.Kid)
+	if err != nil {
+		return err
+	}
+	if err := t.verifySignature(key); err != nil {
+		return err
+	}
+	now := keys.currentTime()
+	if exp := t.Claims.Expiry(); !exp.IsZero() && !now.Before(exp) {
+		return ErrTokenExpired
+	}
+	if t.Claims.NotBefore != 0 && now.Before(time.Unix(t.Claims.NotBefore, 0)) {
+		return ErrTokenNotYetValid
+	}
+	return nil
+}
+
+// verifySignature verifies the signature of the token with key
+func (t *JWT) verifySignature(key *JWK) error {
+	if key.Alg != "" && key.Alg != t.Header.Alg {
+		return errors.Wrap(ErrInvalidSignature, fmt.Sprintf("alg %s does not match the key's %s", t.Header.Alg, key.Alg))
+	}
+	hash, err := jwsHash(t.Header.Alg)
+	if err != nil {
+		return err
+	}
+	h := hash.New()
+	h.Write([]byte(t.signingInput))
+	digest := h.Sum(nil)
+	switch t.Header.Alg[:2] {
+	case "ES":
+		pub, err := key.ECPublicKey()
+		if err != nil {
+			return err
+		}
+		c, err := curveOf(pub)
+		if err != nil {
+			return err
+		}
+		if c.alg != t.Header.Alg || len(t.signature) != 2*c.size {
+			return ErrInvalidSignature
+		}
+		r := new(big.Int).SetBytes(t.signature[:c.size])
+		s := new(big.Int).SetBytes(t.signature[c.size:])
+		if !ecdsa.Verify(pub, digest, r, s) {
+			return ErrInvalidSignature
+		}
+	case "RS":
+		pub, err := key.RSAPublicKey()
+		if err != nil {
+			return err
+		}
+		if rsa.VerifyPKCS1v15(pub, hash, digest, t.signature) != nil {
+			return ErrInvalidSignature
+		}
+	}
+	return nil
+}
+
+// jwsHash returns the hash of a supported JWS algorithm: ES256, ES384, ES512, RS256, RS384 or RS512
+func jwsHash(alg string) (crypto.Hash, error) {
+	switch alg {
+	case "ES256", "RS256":
+		return crypto.SHA256, nil
+	case "ES384", "RS384":
+		return crypto.SHA384, nil
+	case "ES512", "RS512":
+		return crypto.SHA512, nil
+	}
+	return 0, fmt.Errorf("unsupported JWS alg: %s", alg)
+}
+
+// AccessTokenClaims returns the claims of the access token without verifying it, see VerifyJWT
+func (tctx *Context) AccessTokenClaims() (*Claims, error) {
+	jwt, err := ParseJWT(tctx.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	return &jwt.Claims, nil
+}
+
+// IDTokenClaims returns the claims of the ID token without verifying it, see VerifyJWT
+func (tctx *Context) IDTokenClaims() (*Claims, error) {
+	jwt, err := ParseJWT(tctx.IDToken)
+	if err != nil {
+		return nil, err
+	}
+	return &jwt.Claims, nil
+}
+
+// ExpiresAt returns when the access token expires: its exp claim if it is a JWT, otherwise ExpiresIn seconds
+// after StartTime, or the zero time if neither is known
+func (tctx *Context) ExpiresAt() time.Time {
+	if claims, err := tctx.AccessTokenClaims(); err == nil && claims.ExpiresAt != 0 {
+		return claims.Expiry()
+	}
+	if tctx.ExpiresIn == 0 {
+		return time.Time{}
+	}
+	return time.Unix(tctx.StartTime+int64(tctx.ExpiresIn), 0)
+}
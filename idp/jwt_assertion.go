@@ -0,0 +1,352 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package idp
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// ClientAssertionType is the client_assertion_type of JWT client assertions, see RFC 7523
+	ClientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+	// AssertionLifetime is how long client assertions are valid for after they are signed
+	AssertionLifetime = 5 * time.Minute
+)
+
+// SigningKey is an EC private key which signs client assertions, the public key of which is registered on a
+// principal using the identity service's AddPrincipalPublicKey
+type SigningKey struct {
+	// KeyID is the kid of the public key registered on the principal
+	KeyID string
+	// PrivateKey on one of the P-256, P-384 or P-521 curves, signing with ES256, ES384 or ES512 respectively
+	PrivateKey *ecdsa.PrivateKey
+}
+
+// NewSigningKey parses an EC private key in PEM (SEC 1 "EC PRIVATE KEY" or PKCS #8 "PRIVATE KEY") or JWK format.
+// keyID is the kid of the key, if "" the kid of the JWK is used or else the JWK thumbprint of the public key.
+func NewSigningKey(data []byte, keyID string) (*SigningKey, error) {
+	var key *ecdsa.PrivateKey
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		jwk, err := ParseJWK(trimmed)
+		if err != nil {
+			return nil, err
+		}
+		if key, err = jwk.ECPrivateKey(); err != nil {
+			return nil, err
+		}
+		if keyID == "" {
+			keyID = jwk.Kid
+		}
+	} else {
+		block, _ := pem.Decode(data)
+		if block == nil {
+			return nil, errors.New("failed to parse signing key: not a PEM or JWK encoded key")
+		}
+		switch block.Type {
+		case "EC PRIVATE KEY":
+			ecKey, err := x509.ParseECPrivateKey(block.Bytes)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to parse signing key")
+			}
+			key = ecKey
+		case "PRIVATE KEY":
+			parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to parse signing key")
+			}
+			ecKey, ok := parsed.(*ecdsa.PrivateKey)
+			if !ok {
+				return nil, fmt.Errorf("failed to parse signing key: not an EC key: %T", parsed)
+			}
+			key = ecKey
+		default:
+			return nil, fmt.Errorf("failed to parse signing key: unsupported PEM block type: %s", block.Type)
+		}
+	}
+	return newSigningKey(key, keyID)
+}
+
+// newSigningKey returns the signing key for key, identified by the JWK thumbprint of its public key if keyID is ""
+func newSigningKey(key *ecdsa.PrivateKey, keyID string) (*SigningKey, error) {
+	jwk, err := NewECJWK(&key.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if keyID == "" {
+		if keyID, err = jwk.Thumbprint(); err != nil {
+			return nil, err
+		}
+	}
+	return &SigningKey{KeyID: keyID, PrivateKey: key}, nil
+}
+
+// LoadSigningKey reads an EC private key in PEM or JWK format from file, see NewSigningKey
+func LoadSigningKey(file string, keyID string) (*SigningKey, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read signing key")
+	}
+	return NewSigningKey(data, keyID)
+}
+
+// GenerateSigningKey generates a new P-256 signing key identified by the JWK thumbprint of its public key, such
+// as when rotating keys: register its PublicJWK on the principal, then add it with JWTAssertionRetriever.AddKey.
+// Use PEM to store the key.
+func GenerateSigningKey() (*SigningKey, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return newSigningKey(key, "")
+}
+
+// Algorithm returns the JWS algorithm of the key: ES256, ES384 or ES512
+func (k *SigningKey) Algorithm() (string, error) {
+	c, err := curveOf(&k.PrivateKey.PublicKey)
+	if err != nil {
+		return "", err
+	}
+	return c.alg, nil
+}
+
+// PublicJWK returns the public key as a JWK with the kid and alg of the key, to be registered on the principal
+func (k *SigningKey) PublicJWK() (*JWK, error) {
+	jwk, err := NewECJWK(&k.PrivateKey.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	jwk.Kid = k.KeyID
+	jwk.Alg, _ = k.Algorithm()
+	return jwk, nil
+}
+
+// PEM returns the private key PEM encoded in SEC 1 format
+func (k *SigningKey) PEM() ([]byte, error) {
+	der, err := x509.MarshalECPrivateKey(k.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), nil
+}
+
+// assertionClaims are the claims of a client assertion, see RFC 7523 section 3
+type assertionClaims struct {
+	Issuer    string `json:"iss"`
+	Subject   string `json:"sub"`
+	Audience  string `json:"aud"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+	ID        string `json:"jti"`
+}
+
+// assertion returns a client assertion for clientID signed by the key, audience is the token endpoint url
+func (k *SigningKey) assertion(clientID string, audience string, now time.Time) (string, error) {
+	c, err := curveOf(&k.PrivateKey.PublicKey)
+	if err != nil {
+		return "", err
+	}
+	jti := make([]byte, 16)
+	if _, err := rand.Read(jti); err != nil {
+		return "", err
+	}
+	header, err := json.Marshal(map[string]string{"alg": c.alg, "typ": "JWT", "kid": k.KeyID})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(assertionClaims{
+		Issuer:    clientID,
+		Subject:   clientID,
+		Audience:  audience,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(AssertionLifetime).Unix(),
+		ID:        hex.EncodeToString(jti),
+	})
+	if err != nil {
+		return "", err
+	}
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	hash, err := jwsHash(c.alg)
+	if err != nil {
+		return "", err
+	}
+	h := hash.New()
+	h.Write([]byte(signingInput))
+	r, s, err := ecdsa.Sign(rand.Reader, k.PrivateKey, h.Sum(nil))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to sign client assertion")
+	}
+	// JWS signatures are r || s, each left padded to the size of the curve
+	signature := make([]byte, 2*c.size)
+	r.FillBytes(signature[:c.size])
+	s.FillBytes(signature[c.size:])
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// JWTAssertionFlow will authenticate using the "client credentials" flow with a JWT client assertion signed by
+// key in place of a client secret.
+func (c *Client) JWTAssertionFlow(clientID string, key *SigningKey, scope string) (*Context, error) {
+	return c.JWTAssertionFlowWithContext(context.Background(), clientID, key, scope)
+}
+
+// JWTAssertionFlowWithContext will authenticate using the "client credentials" flow with a JWT client assertion
+// signed by key, binding requests to ctx.
+func (c *Client) JWTAssertionFlowWithContext(ctx context.Context, clientID string, key *SigningKey, scope string) (*Context, error) {
+	tokenURL, err := c.clientTokenURL()
+	if err != nil {
+		return nil, err
+	}
+	assertion, err := key.assertion(clientID, tokenURL, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	form := url.Values{
+		"grant_type":            {"client_credentials"},
+		"client_id":             {clientID},
+		"client_assertion_type": {ClientAssertionType},
+		"client_assertion":      {assertion},
+		"scope":                 {scope}}
+
+	request, err := newFormPost(ctx, tokenURL, form)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to create request to token endpoint url: %s", tokenURL))
+	}
+	return c.clientToken(request, tokenURL)
+}
+
+// JWTAssertionRetriever retries a request after getting a new access token from the identity provider using the Client Credentials flow
+// authenticated with client assertions signed by the private key of a principal, rather than a shared client secret
+type JWTAssertionRetriever struct {
+	*Client
+	// ClientID of the principal to authenticate as, the issuer and subject of client assertions
+	ClientID string
+	// Scope(s) to request, separated by spaces -- this will be a custom scope, for example: "backend_service"
+	Scope string
+
+	mux sync.Mutex
+	// keys are the signing keys, most recently added first
+	keys []*SigningKey
+}
+
+// NewJWTAssertionRetriever initializes a new token context retriever
+//
+//	idpURL: should be of the form https://example.com or optionally https://example.com:port
+//	  - if "" is specified then SplunkCloudIdpURL will be used.
+func NewJWTAssertionRetriever(clientID string, scope string, key *SigningKey, idpHost string, overrideAuthURL string, hostURLConfig HostURLConfig) *JWTAssertionRetriever {
+	tr := &JWTAssertionRetriever{
+		Client:   makeClient(idpHost, overrideAuthURL, false, hostURLConfig),
+		ClientID: clientID,
+		Scope:    scope,
+	}
+	if key != nil {
+		tr.AddKey(key)
+	}
+	return tr
+}
+
+// AddKey adds key, replacing any key with the same KeyID, and signs client assertions with it from now on. Keys
+// added before are kept and tried in turn, most recent first, whenever the identity provider rejects an assertion,
+// such that a key can be rotated without downtime: add the new key, register its public key on the principal,
+// then delete the old public key from the principal and remove the old key with RemoveKey.
+func (tr *JWTAssertionRetriever) AddKey(key *SigningKey) {
+	tr.mux.Lock()
+	defer tr.mux.Unlock()
+	keys := []*SigningKey{key}
+	for _, k := range tr.keys {
+		if k.KeyID != key.KeyID {
+			keys = append(keys, k)
+		}
+	}
+	tr.keys = keys
+}
+
+// RemoveKey removes the key with keyID, returning whether it was found
+func (tr *JWTAssertionRetriever) RemoveKey(keyID string) bool {
+	tr.mux.Lock()
+	defer tr.mux.Unlock()
+	for i, k := range tr.keys {
+		if k.KeyID == keyID {
+			tr.keys = append(tr.keys[:i:i], tr.keys[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// KeyIDs returns the ids of the signing keys, most recently added first
+func (tr *JWTAssertionRetriever) KeyIDs() []string {
+	tr.mux.Lock()
+	defer tr.mux.Unlock()
+	ids := make([]string, len(tr.keys))
+	for i, k := range tr.keys {
+		ids[i] = k.KeyID
+	}
+	return ids
+}
+
+// GetTokenContext gets a new access token context from the identity provider
+func (tr *JWTAssertionRetriever) GetTokenContext() (*Context, error) {
+	return tr.GetTokenContextWithContext(context.Background())
+}
+
+// GetTokenContextWithContext gets a new access token context from the identity provider, binding requests to ctx
+func (tr *JWTAssertionRetriever) GetTokenContextWithContext(ctx context.Context) (*Context, error) {
+	tr.mux.Lock()
+	keys := append([]*SigningKey{}, tr.keys...)
+	tr.mux.Unlock()
+	if len(keys) == 0 {
+		return nil, errors.New("failed to get token in JWT assertion flow: no signing key")
+	}
+	var err error
+	for _, key := range keys {
+		var tctx *Context
+		tctx, err = tr.JWTAssertionFlowWithContext(ctx, tr.ClientID, key, tr.Scope)
+		if err == nil {
+			return tctx, nil
+		}
+		if !isRejectedAssertion(err) {
+			break
+		}
+	}
+	return nil, errors.Wrap(err, "failed to get token in JWT assertion flow")
+}
+
+// isRejectedAssertion returns whether err is the identity provider rejecting a client assertion, such as one
+// signed by a key which is not registered on the principal
+func isRejectedAssertion(err error) bool {
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) {
+		return false
+	}
+	return httpErr.HTTPStatusCode == http.StatusUnauthorized || httpErr.Code == "invalid_client"
+}
@@ -0,0 +1,214 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package idp
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// verifyAssertion verifies the signature of an ES256 or ES384 client assertion with pub, returning its header
+// and claims
+func verifyAssertion(t *testing.T, assertion string, pub *ecdsa.PublicKey) (map[string]string, assertionClaims) {
+	parts := strings.Split(assertion, ".")
+	require.Len(t, parts, 3)
+	var header map[string]string
+	var claims assertionClaims
+	data, err := base64.RawURLEncoding.DecodeString(parts[0])
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &header))
+	data, err = base64.RawURLEncoding.DecodeString(parts[1])
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &claims))
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	require.NoError(t, err)
+	size := len(signature) / 2
+	r, s := new(big.Int).SetBytes(signature[:size]), new(big.Int).SetBytes(signature[size:])
+	var digest []byte
+	if header["alg"] == "ES384" {
+		sum := sha512.Sum384([]byte(parts[0] + "." + parts[1]))
+		digest = sum[:]
+	} else {
+		sum := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+		digest = sum[:]
+	}
+	assert.True(t, ecdsa.Verify(pub, digest, r, s), "invalid signature")
+	return header, claims
+}
+
+func TestNewSigningKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	sec1, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	jwk, err := NewECJWK(&key.PublicKey)
+	require.NoError(t, err)
+	thumbprint, err := jwk.Thumbprint()
+	require.NoError(t, err)
+
+	for name, data := range map[string][]byte{
+		"sec1":  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: sec1}),
+		"pkcs8": pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8}),
+	} {
+		sk, err := NewSigningKey(data, "")
+		require.NoError(t, err, name)
+		assert.Equal(t, thumbprint, sk.KeyID, name)
+		assert.True(t, key.Equal(sk.PrivateKey), name)
+		alg, err := sk.Algorithm()
+		require.NoError(t, err)
+		assert.Equal(t, "ES384", alg)
+
+		sk, err = NewSigningKey(data, "key-1")
+		require.NoError(t, err, name)
+		assert.Equal(t, "key-1", sk.KeyID, name)
+	}
+
+	// a private JWK round trips, keeping its kid
+	sk, err := newSigningKey(key, "jwk-1")
+	require.NoError(t, err)
+	public, err := sk.PublicJWK()
+	require.NoError(t, err)
+	assert.Equal(t, &JWK{Kty: "EC", Crv: "P-384", X: jwk.X, Y: jwk.Y, Kid: "jwk-1", Alg: "ES384"}, public)
+	private := *public
+	private.D = base64.RawURLEncoding.EncodeToString(key.D.FillBytes(make([]byte, 48)))
+	data, err := json.Marshal(private)
+	require.NoError(t, err)
+	parsed, err := NewSigningKey(data, "")
+	require.NoError(t, err)
+	assert.Equal(t, "jwk-1", parsed.KeyID)
+	assert.True(t, key.Equal(parsed.PrivateKey))
+
+	// a public JWK cannot sign
+	data, err = json.Marshal(public)
+	require.NoError(t, err)
+	_, err = NewSigningKey(data, "")
+	assert.Error(t, err)
+
+	_, err = NewSigningKey([]byte("not a key"), "")
+	assert.Error(t, err)
+}
+
+func TestJWTAssertionFlow(t *testing.T) {
+	sk, err := GenerateSigningKey()
+	require.NoError(t, err)
+	var tokenURL string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "/token", r.URL.Path)
+		assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "backend_service", r.PostForm.Get("scope"))
+		assert.Equal(t, ClientAssertionType, r.PostForm.Get("client_assertion_type"))
+		header, claims := verifyAssertion(t, r.PostForm.Get("client_assertion"), &sk.PrivateKey.PublicKey)
+		assert.Equal(t, map[string]string{"alg": "ES256", "typ": "JWT", "kid": sk.KeyID}, header)
+		assert.Equal(t, "principal", claims.Issuer)
+		assert.Equal(t, "principal", claims.Subject)
+		assert.Equal(t, tokenURL, claims.Audience)
+		assert.Equal(t, int64(AssertionLifetime.Seconds()), claims.ExpiresAt-claims.IssuedAt)
+		assert.NotEmpty(t, claims.ID)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"token_type":"Bearer","access_token":"token","expires_in":3600}`))
+	}))
+	defer server.Close()
+	tokenURL = server.URL + "/token"
+
+	tr := NewJWTAssertionRetriever("principal", "backend_service", sk, server.URL, "", HostURLConfig{})
+	tctx, err := tr.GetTokenContext()
+	require.NoError(t, err)
+	assert.Equal(t, "token", tctx.AccessToken)
+}
+
+func TestJWTAssertionRetrieverKeyRotation(t *testing.T) {
+	oldKey, err := GenerateSigningKey()
+	require.NoError(t, err)
+	newKey, err := GenerateSigningKey()
+	require.NoError(t, err)
+
+	var mux sync.Mutex
+	registered := map[string]bool{oldKey.KeyID: true}
+	var kids []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		header, err := base64.RawURLEncoding.DecodeString(strings.Split(r.PostForm.Get("client_assertion"), ".")[0])
+		require.NoError(t, err)
+		var h map[string]string
+		require.NoError(t, json.Unmarshal(header, &h))
+		mux.Lock()
+		defer mux.Unlock()
+		kids = append(kids, h["kid"])
+		if !registered[h["kid"]] {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"invalid_client","error_description":"unknown key"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"token_type":"Bearer","access_token":"` + h["kid"] + `","expires_in":3600}`))
+	}))
+	defer server.Close()
+
+	tr := NewJWTAssertionRetriever("principal", "backend_service", oldKey, server.URL, "", HostURLConfig{})
+	tr.AddKey(newKey)
+	assert.Equal(t, []string{newKey.KeyID, oldKey.KeyID}, tr.KeyIDs())
+
+	// the new key is not yet registered on the principal so the old key is used
+	tctx, err := tr.GetTokenContext()
+	require.NoError(t, err)
+	assert.Equal(t, oldKey.KeyID, tctx.AccessToken)
+	assert.Equal(t, []string{newKey.KeyID, oldKey.KeyID}, kids)
+
+	// once registered the new key is used
+	mux.Lock()
+	registered[newKey.KeyID] = true
+	kids = nil
+	mux.Unlock()
+	tctx, err = tr.GetTokenContext()
+	require.NoError(t, err)
+	assert.Equal(t, newKey.KeyID, tctx.AccessToken)
+	assert.Equal(t, []string{newKey.KeyID}, kids)
+
+	// once no key is registered the rejection is returned
+	assert.True(t, tr.RemoveKey(newKey.KeyID))
+	assert.False(t, tr.RemoveKey(newKey.KeyID))
+	mux.Lock()
+	registered = map[string]bool{}
+	mux.Unlock()
+	_, err = tr.GetTokenContext()
+	require.Error(t, err)
+	var httpErr *HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, "invalid_client", httpErr.Code)
+
+	assert.True(t, tr.RemoveKey(oldKey.KeyID))
+	_, err = tr.GetTokenContext()
+	assert.EqualError(t, err, "failed to get token in JWT assertion flow: no signing key")
+}
@@ -0,0 +1,211 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package idp
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// signJWT returns a token with the given claims signed by an EC or RSA private key
+func signJWT(t *testing.T, key crypto.Signer, alg, kid string, claims map[string]interface{}) string {
+	header, err := json.Marshal(JWTHeader{Alg: alg, Kid: kid, Typ: "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	hash, err := jwsHash(alg)
+	require.NoError(t, err)
+	h := hash.New()
+	h.Write([]byte(signingInput))
+	var signature []byte
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		c, err := curveOf(&k.PublicKey)
+		require.NoError(t, err)
+		r, s, err := ecdsa.Sign(rand.Reader, k, h.Sum(nil))
+		require.NoError(t, err)
+		signature = make([]byte, 2*c.size)
+		r.FillBytes(signature[:c.size])
+		s.FillBytes(signature[c.size:])
+	case *rsa.PrivateKey:
+		signature, err = rsa.SignPKCS1v15(rand.Reader, k, hash, h.Sum(nil))
+		require.NoError(t, err)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func rsaJWK(pub *rsa.PublicKey, kid string) JWK {
+	return JWK{
+		Kty: "RSA",
+		N:   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		Kid: kid,
+		Alg: "RS256",
+	}
+}
+
+func TestParseJWTClaims(t *testing.T) {
+	key, err := GenerateSigningKey()
+	require.NoError(t, err)
+	token := signJWT(t, key.PrivateKey, "ES256", "kid1", map[string]interface{}{
+		"iss":    "https://auth.scp.splunk.com",
+		"sub":    "user@example.com",
+		"aud":    "https://api.scp.splunk.com",
+		"exp":    1700003600,
+		"iat":    1700000000,
+		"cid":    "client",
+		"tenant": "mytenant",
+		"scp":    []string{"openid", "email"},
+	})
+	jwt, err := ParseJWT(token)
+	require.NoError(t, err)
+	assert.Equal(t, JWTHeader{Alg: "ES256", Kid: "kid1", Typ: "JWT"}, jwt.Header)
+	claims := jwt.Claims
+	assert.Equal(t, "https://auth.scp.splunk.com", claims.Issuer)
+	assert.Equal(t, "user@example.com", claims.Subject)
+	assert.Equal(t, []string{"https://api.scp.splunk.com"}, claims.Audience)
+	assert.Equal(t, time.Unix(1700003600, 0), claims.Expiry())
+	assert.Equal(t, int64(1700000000), claims.IssuedAt)
+	assert.Equal(t, "client", claims.ClientID)
+	assert.Equal(t, "mytenant", claims.Tenant)
+	assert.True(t, claims.HasScope("email"))
+	assert.False(t, claims.HasScope("profile"))
+	assert.Equal(t, "mytenant", claims.Raw["tenant"])
+
+	// scopes may be a space separated scope claim
+	token = signJWT(t, key.PrivateKey, "ES256", "kid1", map[string]interface{}{"scope": "openid profile", "client_id": "other"})
+	jwt, err = ParseJWT(token)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"openid", "profile"}, jwt.Claims.Scopes)
+	assert.Equal(t, "other", jwt.Claims.ClientID)
+	assert.True(t, jwt.Claims.Expiry().IsZero())
+
+	for _, malformed := range []string{"", "opaque-token", "a.b", "!!.e30.sig", "e30.!!.sig"} {
+		_, err = ParseJWT(malformed)
+		assert.Error(t, err, malformed)
+	}
+}
+
+func TestContextExpiresAt(t *testing.T) {
+	key, err := GenerateSigningKey()
+	require.NoError(t, err)
+	// the exp claim is used rather than the local start time, which may be skewed
+	token := signJWT(t, key.PrivateKey, "ES256", "kid1", map[string]interface{}{"exp": 1700001000})
+	tctx := &Context{AccessToken: token, StartTime: 1700000000, ExpiresIn: 3600}
+	assert.Equal(t, time.Unix(1700001000, 0), tctx.ExpiresAt())
+
+	tctx = &Context{AccessToken: "opaque", StartTime: 1700000000, ExpiresIn: 3600}
+	assert.Equal(t, time.Unix(1700003600, 0), tctx.ExpiresAt())
+	_, err = tctx.AccessTokenClaims()
+	assert.Error(t, err)
+
+	tctx = &Context{AccessToken: "opaque"}
+	assert.True(t, tctx.ExpiresAt().IsZero())
+}
+
+func TestVerifyJWT(t *testing.T) {
+	ecKey, err := GenerateSigningKey()
+	require.NoError(t, err)
+	ecJWK, err := ecKey.PublicJWK()
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	var fetches int32
+	keys := []JWK{*ecJWK}
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/.well-known/openid-configuration":
+			_ = json.NewEncoder(w).Encode(map[string]string{"jwks_uri": server.URL + "/keys"})
+		case "/keys":
+			atomic.AddInt32(&fetches, 1)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"keys": keys})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "", "", "", "", "", "", "", false, HostURLConfig{})
+	ks, err := client.KeySet()
+	require.NoError(t, err)
+	now := time.Unix(1700000000, 0)
+	ks.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	token := signJWT(t, ecKey.PrivateKey, "ES256", ecKey.KeyID, map[string]interface{}{"sub": "user", "exp": now.Unix() + 60})
+	jwt, err := VerifyJWT(ctx, token, ks)
+	require.NoError(t, err)
+	assert.Equal(t, "user", jwt.Claims.Subject)
+	assert.Equal(t, server.URL+"/keys", ks.URL)
+
+	// keys are cached
+	_, err = VerifyJWT(ctx, token, ks)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&fetches))
+
+	// a tampered token is rejected
+	other := signJWT(t, ecKey.PrivateKey, "ES256", ecKey.KeyID, map[string]interface{}{"sub": "admin", "exp": now.Unix() + 60})
+	_, err = VerifyJWT(ctx, other[:len(other)-86]+token[len(token)-86:], ks)
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+
+	// expired and not yet valid tokens are rejected
+	_, err = VerifyJWT(ctx, signJWT(t, ecKey.PrivateKey, "ES256", ecKey.KeyID, map[string]interface{}{"exp": now.Unix()}), ks)
+	assert.ErrorIs(t, err, ErrTokenExpired)
+	_, err = VerifyJWT(ctx, signJWT(t, ecKey.PrivateKey, "ES256", ecKey.KeyID, map[string]interface{}{"nbf": now.Unix() + 60}), ks)
+	assert.ErrorIs(t, err, ErrTokenNotYetValid)
+
+	// a token signed by a new key is rejected until the key is published, refetching at most once a minute
+	token = signJWT(t, rsaKey, "RS256", "rsa1", map[string]interface{}{"exp": now.Unix() + 3600})
+	_, err = VerifyJWT(ctx, token, ks)
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&fetches))
+	keys = append(keys, rsaJWK(&rsaKey.PublicKey, "rsa1"))
+	now = now.Add(keySetRefetchInterval)
+	_, err = VerifyJWT(ctx, token, ks)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&fetches))
+
+	// the key must match the alg of the token
+	_, err = VerifyJWT(ctx, signJWT(t, rsaKey, "RS384", "rsa1", map[string]interface{}{}), ks)
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+	unsigned := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","kid":"rsa1"}`)) + ".e30."
+	_, err = VerifyJWT(ctx, unsigned, ks)
+	assert.Error(t, err)
+
+	// keys are refetched once the cache expires
+	now = now.Add(DefaultKeySetCacheDuration)
+	_, err = VerifyJWT(ctx, token, ks)
+	assert.ErrorIs(t, err, ErrTokenExpired)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&fetches))
+}
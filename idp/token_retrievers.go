@@ -17,6 +17,8 @@
 package idp
 
 import (
+	"context"
+
 	"github.com/pkg/errors"
 	"github.com/splunk/splunk-cloud-sdk-go/util"
 )
@@ -31,6 +33,23 @@ type TokenRetriever interface {
 	GetTokenContext() (*Context, error)
 }
 
+// ContextTokenRetriever retrieves an access token with context, binding any requests made to the identity provider to ctx
+type ContextTokenRetriever interface {
+	TokenRetriever
+	GetTokenContextWithContext(ctx context.Context) (*Context, error)
+}
+
+// RetrieveTokenContext gets a new access token context from tr, honoring ctx if tr implements ContextTokenRetriever
+func RetrieveTokenContext(ctx context.Context, tr TokenRetriever) (*Context, error) {
+	if ctr, ok := tr.(ContextTokenRetriever); ok {
+		return ctr.GetTokenContextWithContext(ctx)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return tr.GetTokenContext()
+}
+
 // NoOpTokenRetriever just returns the same static Context
 type NoOpTokenRetriever struct {
 	Context *Context
@@ -41,6 +60,11 @@ func (tr *NoOpTokenRetriever) GetTokenContext() (*Context, error) {
 	return tr.Context, nil
 }
 
+// GetTokenContextWithContext just returns the same static Context
+func (tr *NoOpTokenRetriever) GetTokenContextWithContext(ctx context.Context) (*Context, error) {
+	return tr.Context, nil
+}
+
 // makeClient creates an *idp.Client
 func makeClient(idpHost string, overrideAuthURL string, insecure bool, hostURLConfig HostURLConfig) *Client {
 	if idpHost == "" {
@@ -94,11 +118,16 @@ func NewRefreshTokenRetriever(clientID string, scope string, refreshToken string
 
 // GetTokenContext gets a new access token context from the identity provider
 func (tr *RefreshTokenRetriever) GetTokenContext() (*Context, error) {
-	ctx, err := tr.Refresh(tr.ClientID, tr.Scope, tr.RefreshToken.ClearText())
+	return tr.GetTokenContextWithContext(context.Background())
+}
+
+// GetTokenContextWithContext gets a new access token context from the identity provider, binding requests to ctx
+func (tr *RefreshTokenRetriever) GetTokenContextWithContext(ctx context.Context) (*Context, error) {
+	tctx, err := tr.RefreshWithContext(ctx, tr.ClientID, tr.Scope, tr.RefreshToken.ClearText())
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get token in refresh token flow")
 	}
-	return ctx, nil
+	return tctx, nil
 }
 
 // ClientCredentialsRetriever retries a request after gettting a new access token from the identity provider using the Client Credentials flow
@@ -126,11 +155,16 @@ func NewClientCredentialsRetriever(clientID string, clientSecret string, scope s
 
 // GetTokenContext gets a new access token context from the identity provider
 func (tr *ClientCredentialsRetriever) GetTokenContext() (*Context, error) {
-	ctx, err := tr.ClientFlow(tr.ClientID, tr.ClientSecret.ClearText(), tr.Scope)
+	return tr.GetTokenContextWithContext(context.Background())
+}
+
+// GetTokenContextWithContext gets a new access token context from the identity provider, binding requests to ctx
+func (tr *ClientCredentialsRetriever) GetTokenContextWithContext(ctx context.Context) (*Context, error) {
+	tctx, err := tr.ClientFlowWithContext(ctx, tr.ClientID, tr.ClientSecret.ClearText(), tr.Scope)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get token in client credentials flow")
 	}
-	return ctx, nil
+	return tctx, nil
 }
 
 // PKCERetriever retries a request after gettting a new access token from the identity provider using the Proof Key for Code Exchange (PKCE) flow
@@ -164,11 +198,16 @@ func NewPKCERetriever(clientID string, redirectURI string, scope string, usernam
 
 // GetTokenContext gets a new access token context from the identity provider
 func (tr *PKCERetriever) GetTokenContext() (*Context, error) {
-	ctx, err := tr.PKCEFlow(tr.ClientID, tr.RedirectURI, tr.Scope, tr.Username, tr.Password.ClearText())
+	return tr.GetTokenContextWithContext(context.Background())
+}
+
+// GetTokenContextWithContext gets a new access token context from the identity provider, binding requests to ctx
+func (tr *PKCERetriever) GetTokenContextWithContext(ctx context.Context) (*Context, error) {
+	tctx, err := tr.PKCEFlowWithContext(ctx, tr.ClientID, tr.RedirectURI, tr.Scope, tr.Username, tr.Password.ClearText())
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get token in PKCE flow")
 	}
-	return ctx, nil
+	return tctx, nil
 }
 
 // DeviceFlowRetriever retries a request after getting a new access token from the identity provider using the Device Authorization Flow
@@ -199,9 +238,14 @@ func NewDeviceFlowRetriever(clientID string, idpHost string, overrideAuthURL str
 
 // GetTokenContext gets a new access token context from the identity provider
 func (tr *DeviceFlowRetriever) GetTokenContext() (*Context, error) {
-	ctx, err := tr.DeviceFlow(tr.ClientID, tr.DeviceCode, tr.ExpiresIn, tr.Interval)
+	return tr.GetTokenContextWithContext(context.Background())
+}
+
+// GetTokenContextWithContext gets a new access token context from the identity provider, binding requests to ctx
+func (tr *DeviceFlowRetriever) GetTokenContextWithContext(ctx context.Context) (*Context, error) {
+	tctx, err := tr.DeviceFlowWithContext(ctx, tr.ClientID, tr.DeviceCode, tr.ExpiresIn, tr.Interval)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get token in Device flow")
 	}
-	return ctx, nil
+	return tctx, nil
 }
@@ -0,0 +1,230 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package idp
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/splunk/splunk-cloud-sdk-go/util"
+	"golang.org/x/crypto/scrypt"
+)
+
+// TokenStore persists a token context, such as between runs of a process, see NewCachingRetriever
+type TokenStore interface {
+	// Load returns the stored token context, or nil if none is stored
+	Load() (*Context, error)
+	// Save stores tctx, replacing any stored token context
+	Save(tctx *Context) error
+	// Delete removes the stored token context, if any
+	Delete() error
+}
+
+// MemoryTokenStore stores a token context in memory, e.g. to share tokens between retrievers of one process
+type MemoryTokenStore struct {
+	mux  sync.Mutex
+	tctx *Context
+}
+
+// NewMemoryTokenStore returns an empty in-memory token store
+func NewMemoryTokenStore() *MemoryTokenStore {
+	return &MemoryTokenStore{}
+}
+
+// Load returns a copy of the stored token context, or nil if none is stored
+func (s *MemoryTokenStore) Load() (*Context, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if s.tctx == nil {
+		return nil, nil
+	}
+	tctx := *s.tctx
+	return &tctx, nil
+}
+
+// Save stores a copy of tctx
+func (s *MemoryTokenStore) Save(tctx *Context) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	stored := *tctx
+	s.tctx = &stored
+	return nil
+}
+
+// Delete removes the stored token context
+func (s *MemoryTokenStore) Delete() error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.tctx = nil
+	return nil
+}
+
+// FileTokenStore stores a token context as JSON in a file readable only by its owner (0600), optionally encrypted
+// with a key derived from a passphrase
+type FileTokenStore struct {
+	// Path of the file, created along with its directory (0700) on Save if needed
+	Path string
+	// Passphrase (optional) encrypts the token context using AES-256-GCM with a key derived using scrypt
+	Passphrase *util.Credential
+	mux        sync.Mutex
+}
+
+// NewFileTokenStore returns a token store persisting to the file at path, encrypted if passphrase is not ""
+func NewFileTokenStore(path string, passphrase string) *FileTokenStore {
+	s := &FileTokenStore{Path: path}
+	if passphrase != "" {
+		s.Passphrase = util.NewCredential(passphrase)
+	}
+	return s
+}
+
+// encryptedContext is the content of files of token stores with a passphrase
+type encryptedContext struct {
+	// Salt of the scrypt key derivation
+	Salt []byte `json:"salt"`
+	// Nonce of the AES-GCM encryption
+	Nonce []byte `json:"nonce"`
+	// Ciphertext of the JSON encoded token context
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// scrypt parameters recommended for interactive logins
+const (
+	scryptN      = 1 << 15
+	scryptR      = 8
+	scryptP      = 1
+	scryptKeyLen = 32
+	saltLen      = 16
+)
+
+// Load returns the stored token context, or nil if the file does not exist
+func (s *FileTokenStore) Load() (*Context, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	data, err := ioutil.ReadFile(s.Path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read token store")
+	}
+	var encrypted encryptedContext
+	if err := json.Unmarshal(data, &encrypted); err != nil {
+		return nil, errors.Wrap(err, "failed to parse token store")
+	}
+	if s.Passphrase != nil {
+		if len(encrypted.Ciphertext) == 0 {
+			return nil, errors.New("failed to decrypt token store: not encrypted")
+		}
+		gcm, err := s.cipher(encrypted.Salt)
+		if err != nil {
+			return nil, err
+		}
+		if len(encrypted.Nonce) != gcm.NonceSize() {
+			return nil, errors.New("failed to decrypt token store: invalid nonce")
+		}
+		if data, err = gcm.Open(nil, encrypted.Nonce, encrypted.Ciphertext, nil); err != nil {
+			return nil, errors.New("failed to decrypt token store: wrong passphrase or corrupted file")
+		}
+	} else if len(encrypted.Ciphertext) > 0 {
+		return nil, errors.New("failed to read token store: encrypted, a passphrase is required")
+	}
+	var tctx Context
+	if err := json.Unmarshal(data, &tctx); err != nil {
+		return nil, errors.Wrap(err, "failed to parse token store")
+	}
+	return &tctx, nil
+}
+
+// Save writes tctx to the file, replacing it atomically
+func (s *FileTokenStore) Save(tctx *Context) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	data, err := json.Marshal(tctx)
+	if err != nil {
+		return err
+	}
+	if s.Passphrase != nil {
+		encrypted := encryptedContext{Salt: make([]byte, saltLen)}
+		if _, err := rand.Read(encrypted.Salt); err != nil {
+			return err
+		}
+		gcm, err := s.cipher(encrypted.Salt)
+		if err != nil {
+			return err
+		}
+		encrypted.Nonce = make([]byte, gcm.NonceSize())
+		if _, err := rand.Read(encrypted.Nonce); err != nil {
+			return err
+		}
+		encrypted.Ciphertext = gcm.Seal(nil, encrypted.Nonce, data, nil)
+		if data, err = json.Marshal(encrypted); err != nil {
+			return err
+		}
+	}
+	dir := filepath.Dir(s.Path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return errors.Wrap(err, "failed to create token store directory")
+	}
+	// the temporary file is created with 0600 permissions, renaming it keeps them and never exposes a partial write
+	file, err := ioutil.TempFile(dir, filepath.Base(s.Path)+".*.tmp")
+	if err != nil {
+		return errors.Wrap(err, "failed to write token store")
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return errors.Wrap(err, "failed to write token store")
+	}
+	if err := file.Close(); err != nil {
+		return errors.Wrap(err, "failed to write token store")
+	}
+	if err := os.Rename(file.Name(), s.Path); err != nil {
+		return errors.Wrap(err, "failed to write token store")
+	}
+	return nil
+}
+
+// Delete removes the file, if it exists
+func (s *FileTokenStore) Delete() error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if err := os.Remove(s.Path); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "failed to delete token store")
+	}
+	return nil
+}
+
+// cipher returns the AES-GCM cipher keyed by the passphrase and salt
+func (s *FileTokenStore) cipher(salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(s.Passphrase.ClearText()), salt, scryptN, scryptR, scryptP, scryptKeyLen)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to derive token store key")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
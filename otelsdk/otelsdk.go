@@ -0,0 +1,221 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package otelsdk instruments Splunk Cloud SDK clients using OpenTelemetry. Set services.Config.Tracer to
+// a Tracer to record a span for each SDK operation with child spans for each attempt (including retries)
+// and token refresh, propagate the trace to services using the W3C traceparent header and record
+// request latency and error metrics:
+//
+//	client, err := sdk.NewClient(&services.Config{
+//		Tracer: otelsdk.NewTracer(),
+//		...
+//	})
+package otelsdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/splunk/splunk-cloud-sdk-go/services"
+	"github.com/splunk/splunk-cloud-sdk-go/util"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName identifies this package as the source of spans and metrics
+const instrumentationName = "github.com/splunk/splunk-cloud-sdk-go/otelsdk"
+
+// Attribute keys recorded on spans and metrics
+const (
+	// ServiceKey is the Splunk Cloud service called, e.g. "search"
+	ServiceKey = attribute.Key("splunk.cloud.service")
+	// OperationKey is the SDK operation called, e.g. "CreateJob"
+	OperationKey = attribute.Key("splunk.cloud.operation")
+	// TenantKey is the tenant of the request
+	TenantKey = attribute.Key("splunk.cloud.tenant")
+	// AttemptsKey is the number of attempts made to complete the request
+	AttemptsKey = attribute.Key("splunk.cloud.attempts")
+)
+
+// Metric names
+const (
+	// RequestDurationMetric is a histogram of the duration of SDK operations in milliseconds, including retries
+	RequestDurationMetric = "splunk.cloud.sdk.request.duration"
+	// RequestErrorsMetric counts SDK operations which returned an error
+	RequestErrorsMetric = "splunk.cloud.sdk.request.errors"
+	// RequestAttemptsMetric counts the attempts made to complete SDK operations, including retries
+	RequestAttemptsMetric = "splunk.cloud.sdk.request.attempts"
+)
+
+// Option configures a Tracer
+type Option func(*config)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+	propagator     propagation.TextMapPropagator
+}
+
+// WithTracerProvider sets the TracerProvider used to create spans, the global TracerProvider by default
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = tp
+	}
+}
+
+// WithMeterProvider sets the MeterProvider used to record metrics, the global MeterProvider by default
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = mp
+	}
+}
+
+// WithPropagator sets the propagator used to inject the trace into outgoing requests, W3C trace context
+// (the traceparent and tracestate headers) by default
+func WithPropagator(p propagation.TextMapPropagator) Option {
+	return func(c *config) {
+		c.propagator = p
+	}
+}
+
+// Tracer implements services.Tracer using OpenTelemetry
+type Tracer struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+	duration   metric.Float64Histogram
+	errors     metric.Int64Counter
+	attempts   metric.Int64Counter
+}
+
+var _ services.Tracer = (*Tracer)(nil)
+
+// NewTracer creates a Tracer
+func NewTracer(opts ...Option) *Tracer {
+	c := config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+		propagator:     propagation.TraceContext{},
+	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	meter := c.meterProvider.Meter(instrumentationName, metric.WithInstrumentationVersion(services.Version))
+	t := &Tracer{
+		tracer:     c.tracerProvider.Tracer(instrumentationName, trace.WithInstrumentationVersion(services.Version)),
+		propagator: c.propagator,
+	}
+	// instrument creation only fails for invalid names, in which case a no-op instrument is returned
+	t.duration, _ = meter.Float64Histogram(RequestDurationMetric, metric.WithUnit("ms"),
+		metric.WithDescription("Duration of Splunk Cloud SDK operations, including retries"))
+	t.errors, _ = meter.Int64Counter(RequestErrorsMetric,
+		metric.WithDescription("Number of Splunk Cloud SDK operations which returned an error"))
+	t.attempts, _ = meter.Int64Counter(RequestAttemptsMetric,
+		metric.WithDescription("Number of attempts made to complete Splunk Cloud SDK operations, including retries"))
+	return t
+}
+
+// requestState is shared by the spans of a request through its context
+type requestState struct {
+	attempts uint
+}
+
+type requestStateKey struct{}
+
+// StartRequest starts a span for an SDK operation
+func (t *Tracer) StartRequest(ctx context.Context, op services.Operation, tenant string) (context.Context, func(*http.Response, error)) {
+	attrs := []attribute.KeyValue{ServiceKey.String(op.Service), TenantKey.String(tenant), semconv.HTTPMethod(op.Method)}
+	name := op.Service + " " + op.Method
+	if op.Name != "" {
+		attrs = append(attrs, OperationKey.String(op.Name))
+		name = op.Service + "." + op.Name
+	}
+	state := &requestState{}
+	ctx = context.WithValue(ctx, requestStateKey{}, state)
+	ctx, span := t.tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+	start := time.Now()
+	return ctx, func(response *http.Response, err error) {
+		statusCode := 0
+		if response != nil {
+			statusCode = response.StatusCode
+		}
+		var httpErr *util.HTTPError
+		if errors.As(err, &httpErr) {
+			statusCode = httpErr.HTTPStatusCode
+		}
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPStatusCode(statusCode))
+		}
+		span.SetAttributes(attrs...)
+		span.SetAttributes(AttemptsKey.Int(int(state.attempts)))
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+		measured := metric.WithAttributes(attrs...)
+		t.duration.Record(ctx, float64(time.Since(start))/float64(time.Millisecond), measured)
+		t.attempts.Add(ctx, int64(state.attempts), measured)
+		if err != nil {
+			t.errors.Add(ctx, 1, measured)
+		}
+	}
+}
+
+// StartAttempt starts a client span for an attempt of a request and injects it into the request headers
+func (t *Tracer) StartAttempt(request *services.Request) func(*http.Response, error) {
+	ctx := request.Context()
+	if state, ok := ctx.Value(requestStateKey{}).(*requestState); ok {
+		state.attempts = request.NumAttempts
+	}
+	attrs := []attribute.KeyValue{semconv.HTTPMethod(request.Method), semconv.HTTPURL(request.URL.String())}
+	if request.NumAttempts > 1 {
+		attrs = append(attrs, semconv.HTTPResendCount(int(request.NumAttempts-1)))
+	}
+	ctx, span := t.tracer.Start(ctx, "HTTP "+request.Method, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+	t.propagator.Inject(ctx, propagation.HeaderCarrier(request.Header))
+	return func(response *http.Response, err error) {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		} else {
+			span.SetAttributes(semconv.HTTPStatusCode(response.StatusCode))
+			if response.StatusCode >= 400 {
+				span.SetStatus(codes.Error, response.Status)
+			}
+		}
+		span.End()
+	}
+}
+
+// StartTokenRefresh starts a span for the retrieval of a new access token
+func (t *Tracer) StartTokenRefresh(ctx context.Context) (context.Context, func(error)) {
+	ctx, span := t.tracer.Start(ctx, "token refresh")
+	return ctx, func(err error) {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}
+}
@@ -0,0 +1,128 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package otelsdk
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/splunk/splunk-cloud-sdk-go/idp"
+	"github.com/splunk/splunk-cloud-sdk-go/services"
+	"github.com/splunk/splunk-cloud-sdk-go/services/search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// expiredTokenRetriever returns tokens which have always expired, such that every request refreshes the token
+type expiredTokenRetriever struct{}
+
+func (tr *expiredTokenRetriever) GetTokenContext() (*idp.Context, error) {
+	return &idp.Context{AccessToken: "token", StartTime: time.Now().Unix() - 7200, ExpiresIn: 3600}, nil
+}
+
+// throttledRT responds 429 to the first request then 200 with an empty JSON array
+type throttledRT struct {
+	N            int
+	traceparents []string
+}
+
+func (rt *throttledRT) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.N++
+	rt.traceparents = append(rt.traceparents, req.Header.Get("traceparent"))
+	if rt.N == 1 {
+		return &http.Response{Status: "429 Too Many Requests", StatusCode: 429, Body: ioutil.NopCloser(bytes.NewReader(nil))}, nil
+	}
+	return &http.Response{Status: "200 OK", StatusCode: 200, Body: ioutil.NopCloser(bytes.NewReader([]byte("[]")))}, nil
+}
+
+func TestTracer(t *testing.T) {
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+	tracer := NewTracer(
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+		WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+	)
+	rt := &throttledRT{}
+	client, err := services.NewClient(&services.Config{
+		TokenRetriever: &expiredTokenRetriever{},
+		Tenant:         "mytenant",
+		RetryRequests:  true,
+		RetryConfig: services.RetryStrategyConfig{
+			ConfigurableRetryConfig: &services.ConfigurableRetryConfig{RetryNum: 2, Interval: 1},
+		},
+		Tracer:       tracer,
+		RoundTripper: rt,
+	})
+	require.NoError(t, err)
+	_, err = search.NewService(client).ListJobs(nil)
+	require.NoError(t, err)
+
+	ended := spans.Ended()
+	require.Len(t, ended, 4)
+	refresh, attempt1, attempt2, request := ended[0], ended[1], ended[2], ended[3]
+	assert.Equal(t, "search.ListJobs", request.Name())
+	assert.Equal(t, "token refresh", refresh.Name())
+	assert.Equal(t, "HTTP GET", attempt1.Name())
+	for _, child := range []sdktrace.ReadOnlySpan{refresh, attempt1, attempt2} {
+		assert.Equal(t, request.SpanContext().SpanID(), child.Parent().SpanID())
+	}
+	attrs := attribute.NewSet(request.Attributes()...)
+	for key, expected := range map[attribute.Key]attribute.Value{
+		ServiceKey:         attribute.StringValue("search"),
+		OperationKey:       attribute.StringValue("ListJobs"),
+		TenantKey:          attribute.StringValue("mytenant"),
+		AttemptsKey:        attribute.IntValue(2),
+		"http.status_code": attribute.IntValue(200),
+		"http.method":      attribute.StringValue("GET"),
+	} {
+		value, ok := attrs.Value(key)
+		assert.True(t, ok, "missing attribute %s", key)
+		assert.Equal(t, expected, value, "attribute %s", key)
+	}
+	attrs = attribute.NewSet(attempt2.Attributes()...)
+	value, ok := attrs.Value("http.resend_count")
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), value.AsInt64())
+
+	require.Len(t, rt.traceparents, 2)
+	assert.Contains(t, rt.traceparents[0], attempt1.SpanContext().SpanID().String())
+	assert.Contains(t, rt.traceparents[1], attempt2.SpanContext().SpanID().String())
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	metrics := map[string]metricdata.Aggregation{}
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		metrics[m.Name] = m.Data
+	}
+	duration, ok := metrics[RequestDurationMetric].(metricdata.Histogram[float64])
+	require.True(t, ok)
+	assert.Equal(t, uint64(1), duration.DataPoints[0].Count)
+	attempts, ok := metrics[RequestAttemptsMetric].(metricdata.Sum[int64])
+	require.True(t, ok)
+	assert.Equal(t, int64(2), attempts.DataPoints[0].Value)
+	assert.NotContains(t, metrics, RequestErrorsMetric, "no errors should have been counted")
+}
@@ -0,0 +1,71 @@
+package sdk
+
+import (
+	"github.com/splunk/splunk-cloud-sdk-go/services/action"
+	"github.com/splunk/splunk-cloud-sdk-go/services/appregistry"
+	"github.com/splunk/splunk-cloud-sdk-go/services/catalog"
+	"github.com/splunk/splunk-cloud-sdk-go/services/collect"
+	"github.com/splunk/splunk-cloud-sdk-go/services/forwarders"
+	"github.com/splunk/splunk-cloud-sdk-go/services/identity"
+	"github.com/splunk/splunk-cloud-sdk-go/services/ingest"
+	"github.com/splunk/splunk-cloud-sdk-go/services/kvstore"
+	"github.com/splunk/splunk-cloud-sdk-go/services/ml"
+	"github.com/splunk/splunk-cloud-sdk-go/services/provisioner"
+	"github.com/splunk/splunk-cloud-sdk-go/services/search"
+	"github.com/splunk/splunk-cloud-sdk-go/services/streams"
+)
+
+// Servicer represents the interface of a Client to all services, such that code using a Client can be unit tested
+// with a MockClient
+type Servicer interface {
+	Action() action.Servicer
+	AppRegistry() appregistry.Servicer
+	Catalog() catalog.Servicer
+	Collect() collect.Servicer
+	Forwarders() forwarders.Servicer
+	Identity() identity.Servicer
+	Ingest() ingest.Servicer
+	KVStore() kvstore.Servicer
+	MachineLearning() ml.Servicer
+	Provisioner() provisioner.Servicer
+	Search() search.Servicer
+	Streams() streams.Servicer
+}
+
+var _ Servicer = (*Client)(nil)
+
+// Action returns ActionService
+func (c *Client) Action() action.Servicer { return c.ActionService }
+
+// AppRegistry returns AppRegistryService
+func (c *Client) AppRegistry() appregistry.Servicer { return c.AppRegistryService }
+
+// Catalog returns CatalogService
+func (c *Client) Catalog() catalog.Servicer { return c.CatalogService }
+
+// Collect returns CollectService
+func (c *Client) Collect() collect.Servicer { return c.CollectService }
+
+// Forwarders returns ForwardersService
+func (c *Client) Forwarders() forwarders.Servicer { return c.ForwardersService }
+
+// Identity returns IdentityService
+func (c *Client) Identity() identity.Servicer { return c.IdentityService }
+
+// Ingest returns IngestService
+func (c *Client) Ingest() ingest.Servicer { return c.IngestService }
+
+// KVStore returns KVStoreService
+func (c *Client) KVStore() kvstore.Servicer { return c.KVStoreService }
+
+// MachineLearning returns MachineLearningService
+func (c *Client) MachineLearning() ml.Servicer { return c.MachineLearningService }
+
+// Provisioner returns ProvisionerService
+func (c *Client) Provisioner() provisioner.Servicer { return c.ProvisionerService }
+
+// Search returns SearchService
+func (c *Client) Search() search.Servicer { return c.SearchService }
+
+// Streams returns StreamsService
+func (c *Client) Streams() streams.Servicer { return c.StreamsService }
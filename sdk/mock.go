@@ -0,0 +1,97 @@
+package sdk
+
+import (
+	"github.com/splunk/splunk-cloud-sdk-go/services/action"
+	"github.com/splunk/splunk-cloud-sdk-go/services/appregistry"
+	"github.com/splunk/splunk-cloud-sdk-go/services/catalog"
+	"github.com/splunk/splunk-cloud-sdk-go/services/collect"
+	"github.com/splunk/splunk-cloud-sdk-go/services/forwarders"
+	"github.com/splunk/splunk-cloud-sdk-go/services/identity"
+	"github.com/splunk/splunk-cloud-sdk-go/services/ingest"
+	"github.com/splunk/splunk-cloud-sdk-go/services/kvstore"
+	"github.com/splunk/splunk-cloud-sdk-go/services/ml"
+	"github.com/splunk/splunk-cloud-sdk-go/services/provisioner"
+	"github.com/splunk/splunk-cloud-sdk-go/services/search"
+	"github.com/splunk/splunk-cloud-sdk-go/services/streams"
+)
+
+// MockClient is a Servicer whose services are the generated mocks, to unit test code using a Client without
+// sending requests:
+//
+//	client := sdk.NewMockClient()
+//	client.IdentityService.ValidateTokenFunc = func(query *identity.ValidateTokenQueryParams, resp ...*http.Response) (*identity.ValidateInfo, error) {
+//		return &identity.ValidateInfo{Name: "someone@domain.com"}, nil
+//	}
+//	// code under test accepting an sdk.Servicer
+//	err := printUser(client)
+//	client.IdentityService.AssertCalled(t, "ValidateToken")
+type MockClient struct {
+	ActionService          *action.MockService
+	AppRegistryService     *appregistry.MockService
+	CatalogService         *catalog.MockService
+	CollectService         *collect.MockService
+	ForwardersService      *forwarders.MockService
+	IdentityService        *identity.MockService
+	IngestService          *ingest.MockService
+	KVStoreService         *kvstore.MockService
+	MachineLearningService *ml.MockService
+	ProvisionerService     *provisioner.MockService
+	SearchService          *search.MockService
+	StreamsService         *streams.MockService
+}
+
+var _ Servicer = (*MockClient)(nil)
+
+// NewMockClient returns a MockClient with a mock for each service, none of which are stubbed
+func NewMockClient() *MockClient {
+	return &MockClient{
+		ActionService:          &action.MockService{},
+		AppRegistryService:     &appregistry.MockService{},
+		CatalogService:         &catalog.MockService{},
+		CollectService:         &collect.MockService{},
+		ForwardersService:      &forwarders.MockService{},
+		IdentityService:        &identity.MockService{},
+		IngestService:          &ingest.MockService{},
+		KVStoreService:         &kvstore.MockService{},
+		MachineLearningService: &ml.MockService{},
+		ProvisionerService:     &provisioner.MockService{},
+		SearchService:          &search.MockService{},
+		StreamsService:         &streams.MockService{},
+	}
+}
+
+// Action returns ActionService
+func (c *MockClient) Action() action.Servicer { return c.ActionService }
+
+// AppRegistry returns AppRegistryService
+func (c *MockClient) AppRegistry() appregistry.Servicer { return c.AppRegistryService }
+
+// Catalog returns CatalogService
+func (c *MockClient) Catalog() catalog.Servicer { return c.CatalogService }
+
+// Collect returns CollectService
+func (c *MockClient) Collect() collect.Servicer { return c.CollectService }
+
+// Forwarders returns ForwardersService
+func (c *MockClient) Forwarders() forwarders.Servicer { return c.ForwardersService }
+
+// Identity returns IdentityService
+func (c *MockClient) Identity() identity.Servicer { return c.IdentityService }
+
+// Ingest returns IngestService
+func (c *MockClient) Ingest() ingest.Servicer { return c.IngestService }
+
+// KVStore returns KVStoreService
+func (c *MockClient) KVStore() kvstore.Servicer { return c.KVStoreService }
+
+// MachineLearning returns MachineLearningService
+func (c *MockClient) MachineLearning() ml.Servicer { return c.MachineLearningService }
+
+// Provisioner returns ProvisionerService
+func (c *MockClient) Provisioner() provisioner.Servicer { return c.ProvisionerService }
+
+// Search returns SearchService
+func (c *MockClient) Search() search.Servicer { return c.SearchService }
+
+// Streams returns StreamsService
+func (c *MockClient) Streams() streams.Servicer { return c.StreamsService }
@@ -0,0 +1,48 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/splunk/splunk-cloud-sdk-go/services/identity"
+	"github.com/splunk/splunk-cloud-sdk-go/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// userName is code under test which uses a Servicer
+func userName(client Servicer) (string, error) {
+	info, err := client.Identity().ValidateToken(&identity.ValidateTokenQueryParams{Include: []identity.ValidateTokenincludeEnum{"principal"}})
+	if err != nil {
+		return "", err
+	}
+	return info.Name, nil
+}
+
+func TestMockClient(t *testing.T) {
+	client := NewMockClient()
+	client.IdentityService.ValidateTokenFunc = func(query *identity.ValidateTokenQueryParams, resp ...*http.Response) (*identity.ValidateInfo, error) {
+		return &identity.ValidateInfo{Name: "someone@domain.com"}, nil
+	}
+	name, err := userName(client)
+	require.NoError(t, err)
+	assert.Equal(t, "someone@domain.com", name)
+	client.IdentityService.AssertCalled(t, "ValidateToken", &identity.ValidateTokenQueryParams{Include: []identity.ValidateTokenincludeEnum{"principal"}})
+	client.IdentityService.AssertNumberOfCalls(t, "ValidateToken", 1)
+	client.SearchService.AssertNotCalled(t, "CreateJob")
+}
+
+func TestMockClientNotStubbed(t *testing.T) {
+	client := NewMockClient()
+	_, err := userName(client)
+	assert.True(t, errors.Is(err, util.ErrNotStubbed))
+
+	_, err = client.Identity().ListMembersAll(nil)
+	assert.True(t, errors.Is(err, util.ErrNotStubbed))
+	pager := client.Identity().ListMembersPages(nil)
+	assert.False(t, pager.Next(context.Background()))
+	assert.True(t, errors.Is(pager.Err(), util.ErrNotStubbed))
+	client.IdentityService.AssertCalled(t, "ListMembersPages", util.Anything)
+}
@@ -19,7 +19,10 @@
 package action
 
 import (
+	"context"
 	"net/http"
+
+	sdkutil "github.com/splunk/splunk-cloud-sdk-go/util"
 )
 
 // ServicerGenerated represents the interface for implementing all endpoints for this service
@@ -89,4 +92,84 @@ type ServicerGenerated interface {
 			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
 	*/
 	UpdateAction(actionName string, actionMutable ActionMutable, resp ...*http.Response) (*Action, error)
+	/*
+		CreateActionWithContext - Creates an action template.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			action: The action template to create.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	CreateActionWithContext(ctx context.Context, action Action, resp ...*http.Response) (*Action, error)
+	/*
+		DeleteActionWithContext - Removes an action template.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			actionName: The name of the action as one or more identifier strings separated by periods. Each identifier string consists of lowercase letters, digits, and underscores, and cannot start with a digit.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	DeleteActionWithContext(ctx context.Context, actionName string, resp ...*http.Response) error
+	/*
+		GetActionWithContext - Returns a specific action template.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			actionName: The name of the action as one or more identifier strings separated by periods. Each identifier string consists of lowercase letters, digits, and underscores, and cannot start with a digit.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetActionWithContext(ctx context.Context, actionName string, resp ...*http.Response) (*Action, error)
+	/*
+		GetActionStatusWithContext - Returns the status of an action that was invoked. The status is available for 4 days after the last status change.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			actionName: The name of the action as one or more identifier strings separated by periods. Each identifier string consists of lowercase letters, digits, and underscores, and cannot start with a digit.
+			statusId: The ID of the action status.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetActionStatusWithContext(ctx context.Context, actionName string, statusId string, resp ...*http.Response) (*ActionResult, error)
+	/*
+		GetActionStatusDetailsWithContext - Returns the status details of the invoked email action. The status is available for 4 days after the last status change.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			actionName: The name of the action as one or more identifier strings separated by periods. Each identifier string consists of lowercase letters, digits, and underscores, and cannot start with a digit.
+			statusId: The ID of the action status.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetActionStatusDetailsWithContext(ctx context.Context, actionName string, statusId string, resp ...*http.Response) ([]ActionResultEmailDetail, error)
+	/*
+		GetPublicWebhookKeysWithContext - Returns an array of one or two webhook keys. The first key is active. The second key, if present, is expired.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetPublicWebhookKeysWithContext(ctx context.Context, resp ...*http.Response) ([]PublicWebhookKey, error)
+	/*
+		ListActionsWithContext - Returns the list of action templates.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListActionsWithContext(ctx context.Context, resp ...*http.Response) ([]Action, error)
+	/*
+		TriggerActionWithContext - Invokes an action.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			actionName: The name of the action as one or more identifier strings separated by periods. Each identifier string consists of lowercase letters, digits, and underscores, and cannot start with a digit.
+			triggerEvent: The action payload, which should include values for any templated fields.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	TriggerActionWithContext(ctx context.Context, actionName string, triggerEvent TriggerEvent, resp ...*http.Response) error
+	/*
+		UpdateActionWithContext - Modifies an action template.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			actionName: The name of the action as one or more identifier strings separated by periods. Each identifier string consists of lowercase letters, digits, and underscores, and cannot start with a digit.
+			actionMutable: Updates to the action template.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	UpdateActionWithContext(ctx context.Context, actionName string, actionMutable ActionMutable, resp ...*http.Response) (*Action, error)
+	// ListActionsPages returns a Pager over the pages of ListActions, which returns a single page
+	ListActionsPages() *sdkutil.Pager[Action]
+	// ListActionsAll returns the items of all pages of ListActions
+	ListActionsAll() ([]Action, error)
+	// ListActionsAllWithContext - ListActionsAll bound to ctx
+	ListActionsAllWithContext(ctx context.Context) ([]Action, error)
 }
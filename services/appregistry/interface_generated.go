@@ -19,7 +19,10 @@
 package appregistry
 
 import (
+	"context"
 	"net/http"
+
+	sdkutil "github.com/splunk/splunk-cloud-sdk-go/util"
 )
 
 // ServicerGenerated represents the interface for implementing all endpoints for this service
@@ -119,4 +122,129 @@ type ServicerGenerated interface {
 			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
 	*/
 	UpdateApp(appName string, updateAppRequest UpdateAppRequest, resp ...*http.Response) (*AppResponseCreateUpdate, error)
+	/*
+		CreateAppWithContext - appregistry service endpoint
+		Creates an app.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			createAppRequest: Creates a new app.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	CreateAppWithContext(ctx context.Context, createAppRequest CreateAppRequest, resp ...*http.Response) (*AppResponseCreateUpdate, error)
+	/*
+		CreateSubscriptionWithContext - appregistry service endpoint
+		Creates a subscription.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			appName: Creates a subscription between a tenant and an app.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	CreateSubscriptionWithContext(ctx context.Context, appName AppName, resp ...*http.Response) error
+	/*
+		DeleteAppWithContext - appregistry service endpoint
+		Removes an app.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			appName: App name.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	DeleteAppWithContext(ctx context.Context, appName string, resp ...*http.Response) error
+	/*
+		DeleteSubscriptionWithContext - appregistry service endpoint
+		Removes a subscription.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			appName: App name.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	DeleteSubscriptionWithContext(ctx context.Context, appName string, resp ...*http.Response) error
+	/*
+		GetAppWithContext - appregistry service endpoint
+		Returns the metadata of an app.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			appName: App name.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetAppWithContext(ctx context.Context, appName string, resp ...*http.Response) (*AppResponseGetList, error)
+	/*
+		GetKeysWithContext - appregistry service endpoint
+		Returns a list of the public keys used for verifying signed webhook requests.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetKeysWithContext(ctx context.Context, resp ...*http.Response) ([]Key, error)
+	/*
+		GetSubscriptionWithContext - appregistry service endpoint
+		Returns or validates a subscription.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			appName: App name.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetSubscriptionWithContext(ctx context.Context, appName string, resp ...*http.Response) (*Subscription, error)
+	/*
+		ListAppSubscriptionsWithContext - appregistry service endpoint
+		Returns the collection of subscriptions to an app.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			appName: App name.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListAppSubscriptionsWithContext(ctx context.Context, appName string, resp ...*http.Response) ([]Subscription, error)
+	/*
+		ListAppsWithContext - appregistry service endpoint
+		Returns a list of apps.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListAppsWithContext(ctx context.Context, resp ...*http.Response) ([]AppResponseGetList, error)
+	/*
+		ListSubscriptionsWithContext - appregistry service endpoint
+		Returns the tenant subscriptions.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			query: a struct pointer of valid query parameters for the endpoint, nil to send no query parameters
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListSubscriptionsWithContext(ctx context.Context, query *ListSubscriptionsQueryParams, resp ...*http.Response) ([]Subscription, error)
+	/*
+		RotateSecretWithContext - appregistry service endpoint
+		Rotates the client secret for an app.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			appName: App name.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	RotateSecretWithContext(ctx context.Context, appName string, resp ...*http.Response) (*AppResponseCreateUpdate, error)
+	/*
+		UpdateAppWithContext - appregistry service endpoint
+		Updates an app.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			appName: App name.
+			updateAppRequest: Updates app contents.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	UpdateAppWithContext(ctx context.Context, appName string, updateAppRequest UpdateAppRequest, resp ...*http.Response) (*AppResponseCreateUpdate, error)
+	// ListAppSubscriptionsPages returns a Pager over the pages of ListAppSubscriptions, which returns a single page
+	ListAppSubscriptionsPages(appName string) *sdkutil.Pager[Subscription]
+	// ListAppSubscriptionsAll returns the items of all pages of ListAppSubscriptions
+	ListAppSubscriptionsAll(appName string) ([]Subscription, error)
+	// ListAppSubscriptionsAllWithContext - ListAppSubscriptionsAll bound to ctx
+	ListAppSubscriptionsAllWithContext(ctx context.Context, appName string) ([]Subscription, error)
+	// ListAppsPages returns a Pager over the pages of ListApps, which returns a single page
+	ListAppsPages() *sdkutil.Pager[AppResponseGetList]
+	// ListAppsAll returns the items of all pages of ListApps
+	ListAppsAll() ([]AppResponseGetList, error)
+	// ListAppsAllWithContext - ListAppsAll bound to ctx
+	ListAppsAllWithContext(ctx context.Context) ([]AppResponseGetList, error)
+	// ListSubscriptionsPages returns a Pager over the pages of ListSubscriptions, which returns a single page
+	ListSubscriptionsPages(query *ListSubscriptionsQueryParams) *sdkutil.Pager[Subscription]
+	// ListSubscriptionsAll returns the items of all pages of ListSubscriptions
+	ListSubscriptionsAll(query *ListSubscriptionsQueryParams) ([]Subscription, error)
+	// ListSubscriptionsAllWithContext - ListSubscriptionsAll bound to ctx
+	ListSubscriptionsAllWithContext(ctx context.Context, query *ListSubscriptionsQueryParams) ([]Subscription, error)
 }
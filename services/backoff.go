@@ -0,0 +1,108 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package services
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Backoff determines how long to wait before retrying a request
+type Backoff interface {
+	// Next returns the delay to wait before the next attempt given the number of attempts made so far and
+	// the time elapsed since the request was created, ok is false if no further attempts should be made
+	Next(attempts uint, elapsed time.Duration) (delay time.Duration, ok bool)
+}
+
+// ExponentialBackoff implements Backoff by doubling the delay after each attempt such that
+// delay = Interval * 2^attempts, optionally capped at MaxDelay and randomized using full jitter
+// such that delay = random(0, min(MaxDelay, Interval * 2^attempts))
+type ExponentialBackoff struct {
+	// Interval is the base interval to use for exponential backoff
+	Interval time.Duration
+	// MaxDelay is the (optional) maximum delay between attempts, no maximum is applied by default
+	MaxDelay time.Duration
+	// MaxElapsedTime is the (optional) maximum time since the request was created after which no further
+	// attempts are made, no maximum is applied by default
+	MaxElapsedTime time.Duration
+	// FullJitter if true randomizes the delay between zero and the computed exponential delay, which
+	// prevents many clients throttled at the same time from retrying in lockstep
+	FullJitter bool
+}
+
+// Next returns the delay to wait before the next attempt
+func (b ExponentialBackoff) Next(attempts uint, elapsed time.Duration) (time.Duration, bool) {
+	if b.MaxElapsedTime > 0 && elapsed >= b.MaxElapsedTime {
+		return 0, false
+	}
+	delay := time.Duration(math.MaxInt64)
+	// guard against overflowing when shifting the interval
+	if attempts < 63 && b.Interval <= time.Duration(math.MaxInt64>>attempts) {
+		delay = b.Interval << attempts
+	}
+	if b.MaxDelay > 0 && delay > b.MaxDelay {
+		delay = b.MaxDelay
+	}
+	if b.FullJitter && delay > 0 {
+		delay = time.Duration(rand.Int63n(int64(delay)))
+	}
+	if b.MaxElapsedTime > 0 && elapsed+delay > b.MaxElapsedTime {
+		// wait no longer than the time remaining for the final attempt
+		delay = b.MaxElapsedTime - elapsed
+	}
+	return delay, true
+}
+
+// retryAfter returns the delay requested by the Retry-After header of the response, if any, which may be
+// specified as either a number of seconds or an HTTP date
+func retryAfter(response *http.Response) (time.Duration, bool) {
+	if response == nil {
+		return 0, false
+	}
+	value := strings.TrimSpace(response.Header.Get("Retry-After"))
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
+
+// isIdempotent returns whether the request method is idempotent as defined by RFC 7231, such that
+// the request can safely be retried if the outcome of a previous attempt is unknown
+func isIdempotent(request *Request) bool {
+	switch request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete, http.MethodTrace:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package services
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExponentialBackoff(t *testing.T) {
+	b := ExponentialBackoff{Interval: 100 * time.Millisecond}
+	for attempts, expected := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond} {
+		delay, ok := b.Next(uint(attempts), 0)
+		assert.True(t, ok)
+		assert.Equal(t, expected, delay)
+	}
+	// large attempt counts should not overflow
+	delay, ok := b.Next(100, 0)
+	assert.True(t, ok)
+	assert.True(t, delay > 0)
+}
+
+func TestExponentialBackoffMaxDelay(t *testing.T) {
+	b := ExponentialBackoff{Interval: 100 * time.Millisecond, MaxDelay: 300 * time.Millisecond}
+	delay, _ := b.Next(1, 0)
+	assert.Equal(t, 200*time.Millisecond, delay)
+	delay, _ = b.Next(2, 0)
+	assert.Equal(t, 300*time.Millisecond, delay)
+	delay, _ = b.Next(100, 0)
+	assert.Equal(t, 300*time.Millisecond, delay)
+}
+
+func TestExponentialBackoffFullJitter(t *testing.T) {
+	b := ExponentialBackoff{Interval: 100 * time.Millisecond, MaxDelay: time.Second, FullJitter: true}
+	for i := 0; i < 100; i++ {
+		delay, ok := b.Next(3, 0)
+		assert.True(t, ok)
+		assert.True(t, delay >= 0 && delay < 800*time.Millisecond, "delay %s should be in [0, 800ms)", delay)
+	}
+}
+
+func TestExponentialBackoffMaxElapsedTime(t *testing.T) {
+	b := ExponentialBackoff{Interval: 100 * time.Millisecond, MaxElapsedTime: time.Second}
+	delay, ok := b.Next(3, 900*time.Millisecond)
+	assert.True(t, ok)
+	assert.Equal(t, 100*time.Millisecond, delay, "delay should be limited to the time remaining")
+	_, ok = b.Next(0, time.Second)
+	assert.False(t, ok)
+}
+
+func TestRetryAfter(t *testing.T) {
+	newResponse := func(value string) *http.Response {
+		header := http.Header{}
+		header.Set("Retry-After", value)
+		return &http.Response{Header: header}
+	}
+	delay, ok := retryAfter(newResponse("3"))
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, delay)
+
+	date := time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat)
+	delay, ok = retryAfter(newResponse(date))
+	assert.True(t, ok)
+	assert.True(t, delay > 8*time.Second && delay <= 10*time.Second, "unexpected delay %s", delay)
+
+	delay, ok = retryAfter(newResponse(time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat)))
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), delay)
+
+	_, ok = retryAfter(newResponse("soon"))
+	assert.False(t, ok)
+	_, ok = retryAfter(&http.Response{Header: http.Header{}})
+	assert.False(t, ok)
+	_, ok = retryAfter(nil)
+	assert.False(t, ok)
+}
@@ -0,0 +1,162 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package services
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"sync"
+
+	gdepservices "github.com/splunk/go-dependencies/services"
+	"github.com/splunk/splunk-cloud-sdk-go/util"
+)
+
+// ReplayableBody is a request body which can be read from the start again for each attempt of a request,
+// such that retries do not require the whole body to be held in memory. A ReplayableBody can be passed as
+// the Body of RequestParams to be sent as is.
+type ReplayableBody interface {
+	// Open returns a reader of the body from the start, readers previously returned may no longer be read
+	Open() (io.ReadCloser, error)
+}
+
+// ReplayableBodyFunc adapts a func to a ReplayableBody
+type ReplayableBodyFunc func() (io.ReadCloser, error)
+
+// Open calls f()
+func (f ReplayableBodyFunc) Open() (io.ReadCloser, error) {
+	return f()
+}
+
+// pipe returns a reader of the output of write, which is run in a new goroutine. Closing the reader
+// before it is fully read causes writes to fail such that the goroutine exits.
+func pipe(write func(w io.Writer) error) io.ReadCloser {
+	r, w := io.Pipe()
+	go func() {
+		w.CloseWithError(write(w))
+	}()
+	return r
+}
+
+// jsonBody encodes a value as JSON each time it is opened, optionally compressed with gzip
+type jsonBody struct {
+	value  interface{}
+	method string
+	gzip   bool
+}
+
+func (b *jsonBody) Open() (io.ReadCloser, error) {
+	return pipe(func(w io.Writer) error {
+		if !b.gzip {
+			return encodeJSON(w, b.value, b.method)
+		}
+		zw := gzip.NewWriter(w)
+		if err := encodeJSON(zw, b.value, b.method); err != nil {
+			return err
+		}
+		return zw.Close()
+	}), nil
+}
+
+// encodeJSON writes value as JSON, using MarshalJSONByMethod if value is a util.MethodMarshaler
+func encodeJSON(w io.Writer, value interface{}, method string) error {
+	if bodyMarshaler, ok := value.(util.MethodMarshaler); ok {
+		content, err := bodyMarshaler.MarshalJSONByMethod(method)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(content)
+		return err
+	}
+	return json.NewEncoder(w).Encode(value)
+}
+
+// multipartBody writes a form file from a seekable stream each time it is opened, seeking back to the
+// position of the stream when the body was created
+type multipartBody struct {
+	forms    gdepservices.FormData
+	seeker   io.Seeker
+	start    int64
+	boundary string
+	mux      sync.Mutex
+	current  io.ReadCloser
+	done     chan struct{}
+}
+
+func newMultipartBody(forms gdepservices.FormData, seeker io.Seeker) (*multipartBody, error) {
+	start, err := seeker.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, err
+	}
+	return &multipartBody{
+		forms:    forms,
+		seeker:   seeker,
+		start:    start,
+		boundary: multipart.NewWriter(nil).Boundary(),
+	}, nil
+}
+
+// contentType returns the Content-Type of the body, including the multipart boundary
+func (b *multipartBody) contentType() string {
+	return "multipart/form-data; boundary=" + b.boundary
+}
+
+func (b *multipartBody) Open() (io.ReadCloser, error) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	// the stream is shared, so stop writing the previous body before seeking back to the start
+	if b.current != nil {
+		b.current.Close()
+		<-b.done
+	}
+	if _, err := b.seeker.Seek(b.start, io.SeekStart); err != nil {
+		return nil, err
+	}
+	done := make(chan struct{})
+	b.current = pipe(func(w io.Writer) error {
+		defer close(done)
+		writer := multipart.NewWriter(w)
+		if err := writer.SetBoundary(b.boundary); err != nil {
+			return err
+		}
+		part, err := writer.CreateFormFile(b.forms.Key, b.forms.Filename)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(part, b.forms.Stream); err != nil {
+			return err
+		}
+		return writer.Close()
+	})
+	b.done = done
+	return b.current, nil
+}
+
+// gzipBytes returns content compressed with gzip
+func gzipBytes(content []byte) (*bytes.Buffer, error) {
+	var buffer bytes.Buffer
+	zw := gzip.NewWriter(&buffer)
+	if _, err := zw.Write(content); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return &buffer, nil
+}
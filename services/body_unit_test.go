@@ -0,0 +1,181 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package services
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/splunk/go-dependencies/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bodyRT records the bodies of requests, responding 429 to the first request and 200 to the rest
+type bodyRT struct {
+	bodies  [][]byte
+	headers []http.Header
+	lengths []int64
+}
+
+func (rt *bodyRT) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body.Close()
+	rt.bodies = append(rt.bodies, body)
+	rt.headers = append(rt.headers, req.Header.Clone())
+	rt.lengths = append(rt.lengths, req.ContentLength)
+	b := ioutil.NopCloser(bytes.NewReader([]byte("")))
+	if len(rt.bodies) == 1 {
+		return &http.Response{Status: "429 Too Many Requests", StatusCode: 429, Body: b}, nil
+	}
+	return &http.Response{Status: "200 OK", StatusCode: 200, Body: b}, nil
+}
+
+func newBodyClient(t *testing.T, rt http.RoundTripper, stream bool, gzip bool) *BaseClient {
+	client, err := NewClient(&Config{
+		Token:               "testtoken",
+		RetryRequests:       true,
+		RetryConfig:         RetryStrategyConfig{ConfigurableRetryConfig: &ConfigurableRetryConfig{RetryNum: 2, Interval: 1}},
+		StreamRequestBodies: stream,
+		GzipRequestBodies:   gzip,
+		RoundTripper:        rt,
+	})
+	require.Nil(t, err, "Error calling NewClient(): %s", err)
+	return client
+}
+
+func gunzip(t *testing.T, content []byte) string {
+	zr, err := gzip.NewReader(bytes.NewReader(content))
+	require.NoError(t, err)
+	decompressed, err := io.ReadAll(zr)
+	require.NoError(t, err)
+	return string(decompressed)
+}
+
+type record struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+func TestStreamRequestBodies(t *testing.T) {
+	for _, compress := range []bool{false, true} {
+		rt := &bodyRT{}
+		client := newBodyClient(t, rt, true, compress)
+		_, err := client.Post(services.RequestParams{Body: []record{{"a", 1}, {"b", 2}}})
+		require.NoError(t, err)
+		require.Len(t, rt.bodies, 2, "the request should have been retried")
+		for i, body := range rt.bodies {
+			assert.Equal(t, int64(-1), rt.lengths[i], "the body should be streamed")
+			if compress {
+				assert.Equal(t, "gzip", rt.headers[i].Get("Content-Encoding"))
+				assert.JSONEq(t, `[{"name":"a","count":1},{"name":"b","count":2}]`, gunzip(t, body))
+			} else {
+				assert.Empty(t, rt.headers[i].Get("Content-Encoding"))
+				assert.JSONEq(t, `[{"name":"a","count":1},{"name":"b","count":2}]`, string(body))
+			}
+		}
+	}
+}
+
+func TestGzipRequestBodies(t *testing.T) {
+	rt := &bodyRT{}
+	client := newBodyClient(t, rt, false, true)
+	_, err := client.Post(services.RequestParams{Body: record{"a", 1}})
+	require.NoError(t, err)
+	require.Len(t, rt.bodies, 2)
+	for i, body := range rt.bodies {
+		assert.True(t, rt.lengths[i] > 0, "the body should be buffered")
+		assert.Equal(t, "gzip", rt.headers[i].Get("Content-Encoding"))
+		assert.JSONEq(t, `{"name":"a","count":1}`, gunzip(t, body))
+	}
+}
+
+func TestStreamMultipartRequestBodies(t *testing.T) {
+	rt := &bodyRT{}
+	client := newBodyClient(t, rt, true, false)
+	content := strings.Repeat("lookup,value\n", 1000)
+	_, err := client.Post(services.RequestParams{
+		Body:    services.FormData{Key: "upfile", Filename: "lookup.csv", Stream: strings.NewReader(content)},
+		Headers: map[string]string{"Content-Type": "multipart/form-data"},
+	})
+	require.NoError(t, err)
+	require.Len(t, rt.bodies, 2)
+	for i, body := range rt.bodies {
+		assert.Equal(t, int64(-1), rt.lengths[i], "the body should be streamed")
+		mediaType, params, err := mime.ParseMediaType(rt.headers[i].Get("Content-Type"))
+		require.NoError(t, err)
+		assert.Equal(t, "multipart/form-data", mediaType)
+		part, err := multipart.NewReader(bytes.NewReader(body), params["boundary"]).NextPart()
+		require.NoError(t, err)
+		assert.Equal(t, "lookup.csv", part.FileName())
+		assert.Equal(t, "upfile", part.FormName())
+		uploaded, err := io.ReadAll(part)
+		require.NoError(t, err)
+		assert.Equal(t, content, string(uploaded), "each attempt should upload the whole file")
+	}
+}
+
+func TestReplayableBody(t *testing.T) {
+	rt := &bodyRT{}
+	client := newBodyClient(t, rt, false, false)
+	opened := 0
+	body := ReplayableBodyFunc(func() (io.ReadCloser, error) {
+		opened++
+		return ioutil.NopCloser(strings.NewReader("raw body")), nil
+	})
+	_, err := client.Post(services.RequestParams{Body: body})
+	require.NoError(t, err)
+	assert.Equal(t, 2, opened)
+	assert.Equal(t, [][]byte{[]byte("raw body"), []byte("raw body")}, rt.bodies)
+}
+
+// closeRecorder records whether it has been closed
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (r *closeRecorder) Close() error {
+	r.closed = true
+	return nil
+}
+
+func TestVetoedRequestBodyClosed(t *testing.T) {
+	client, err := NewClient(&Config{
+		Token:        "testtoken",
+		RoundTripper: &bodyRT{},
+		RequestHandlers: []RequestHandler{RequestHandlerFunc(func(client *BaseClient, request *Request) error {
+			return errors.New("vetoed")
+		})},
+	})
+	require.NoError(t, err)
+	body := &closeRecorder{Reader: strings.NewReader("raw body")}
+	_, err = client.Post(services.RequestParams{Body: ReplayableBodyFunc(func() (io.ReadCloser, error) { return body, nil })})
+	require.Error(t, err)
+	assert.True(t, body.closed, "the body of a vetoed request should be closed")
+}
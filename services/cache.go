@@ -0,0 +1,384 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package services
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultCacheMaxEntries = 1000
+	defaultCacheMaxBytes   = 10 << 20
+)
+
+// CacheStatusHeader is set on responses returned by a ResponseCache to "hit" if the response was served from
+// the cache without a request, "revalidated" if the service confirmed the cached response is current and
+// "miss" otherwise
+const CacheStatusHeader = "X-Splunk-Cache"
+
+// ResponseCacheConfig configures a ResponseCache, all fields are optional
+type ResponseCacheConfig struct {
+	// TTLs are how long the responses of each service, keyed by the service name as it appears in the request
+	// path, e.g. "catalog", "action" or "streams", are fresh, and returned without a request, if the service does
+	// not specify with Cache-Control or Expires headers. Responses of other services, and by default of all
+	// services, are revalidated on each request if they have an ETag or Last-Modified header and are not
+	// cached otherwise, such that e.g. polling the status of a search job is not answered from the cache
+	TTLs map[string]time.Duration
+	// MaxEntries is the maximum number of responses cached, the least recently used are evicted first. Default to 1000
+	MaxEntries int
+	// MaxBytes is the maximum total size of the cached response bodies, the least recently used are evicted
+	// first. Default to 10MiB
+	MaxBytes int64
+}
+
+// ResponseCache caches the responses of GET requests keyed by their URL, which includes the tenant, and by
+// their credential, such that resources which are read far more often than written need not be fetched again.
+// Streamed responses, see WithStreamedResponse, are never cached. The cache honors the Cache-Control, Expires, ETag and Last-Modified headers of responses, revalidating stale
+// responses with If-None-Match and If-Modified-Since requests, and entries for a resource path are invalidated
+// by requests to it with any other method. Responses with "Cache-Control: private" or "no-store" headers are
+// never cached and a request with a "Cache-Control: no-cache" header is always revalidated. Set
+// Config.ResponseCache to use it:
+//
+//	config := &services.Config{
+//		ResponseCache: services.NewResponseCache(services.ResponseCacheConfig{
+//			TTLs: map[string]time.Duration{"catalog": time.Minute, "action": time.Minute},
+//		}),
+//		...
+//	}
+//
+// A ResponseCache may be shared by several clients, a response is only returned for requests with the same
+// Authorization header as the request it was cached for. Note that a response served from the cache without a
+// request is returned before the RequestHandlers, such as the circuit breaker and rate limiter, and the Tracer
+// of the client are called, so cache hits are neither limited nor traced; revalidations are.
+type ResponseCache struct {
+	config  ResponseCacheConfig
+	mux     sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+	size    int64
+	now     func() time.Time
+}
+
+// cacheEntry is a cached response
+type cacheEntry struct {
+	key        string
+	host       string
+	path       string
+	statusCode int
+	status     string
+	proto      string
+	header     http.Header
+	body       []byte
+	expires    time.Time
+	// ttl is how long the response is fresh if its headers do not specify
+	ttl time.Duration
+}
+
+// NewResponseCache creates an empty ResponseCache
+func NewResponseCache(config ResponseCacheConfig) *ResponseCache {
+	if config.MaxEntries <= 0 {
+		config.MaxEntries = defaultCacheMaxEntries
+	}
+	if config.MaxBytes <= 0 {
+		config.MaxBytes = defaultCacheMaxBytes
+	}
+	return &ResponseCache{
+		config:  config,
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+		now:     time.Now,
+	}
+}
+
+// Len returns the number of cached responses
+func (rc *ResponseCache) Len() int {
+	rc.mux.Lock()
+	defer rc.mux.Unlock()
+	return rc.lru.Len()
+}
+
+// Purge removes all cached responses
+func (rc *ResponseCache) Purge() {
+	rc.mux.Lock()
+	defer rc.mux.Unlock()
+	rc.entries = make(map[string]*list.Element)
+	rc.lru.Init()
+	rc.size = 0
+}
+
+// do sends request with client.Do, unless a fresh response is cached, and caches the response
+func (rc *ResponseCache) do(client *BaseClient, request *Request) (*http.Response, error) {
+	if request.Method != http.MethodGet && request.Method != http.MethodHead {
+		response, err := client.Do(request)
+		rc.invalidate(request.URL.Host, request.URL.Path)
+		return response, err
+	}
+	if request.Method == http.MethodHead || hasDirective(request.Header.Get("Cache-Control"), "no-store") ||
+		isStreamedResponse(request.Context()) {
+		return client.Do(request)
+	}
+	key := cacheKey(request)
+	entry := rc.get(key)
+	if entry != nil {
+		if rc.now().Before(entry.expires) && !hasDirective(request.Header.Get("Cache-Control"), "no-cache") {
+			return entry.response(request.Request, "hit"), nil
+		}
+		if etag := entry.header.Get("ETag"); etag != "" {
+			request.Header.Set("If-None-Match", etag)
+		}
+		if modified := entry.header.Get("Last-Modified"); modified != "" {
+			request.Header.Set("If-Modified-Since", modified)
+		}
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return response, err
+	}
+	if response.Header == nil {
+		response.Header = http.Header{}
+	}
+	if entry != nil && response.StatusCode == http.StatusNotModified {
+		drain(response)
+		entry = rc.revalidated(entry, response.Header)
+		return entry.response(request.Request, "revalidated"), nil
+	}
+	if response.StatusCode != http.StatusOK {
+		if entry != nil {
+			rc.remove(key)
+		}
+		return response, nil
+	}
+	return rc.store(key, request, response)
+}
+
+// store caches response if it is cacheable and returns a response to read the body from in its place
+func (rc *ResponseCache) store(key string, request *Request, response *http.Response) (*http.Response, error) {
+	ttl := rc.config.TTLs[serviceFromPath(request.URL.Path)]
+	expires, ok := rc.expires(response.Header, ttl)
+	if !ok || response.ContentLength > rc.config.MaxBytes {
+		return rc.uncached(key, response), nil
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(response.Body, rc.config.MaxBytes+1))
+	if err != nil {
+		response.Body.Close()
+		return nil, err
+	}
+	if int64(len(body)) > rc.config.MaxBytes {
+		// too big to cache, return the whole body to the caller
+		response.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), response.Body), response.Body}
+		return rc.uncached(key, response), nil
+	}
+	response.Body.Close()
+	entry := &cacheEntry{
+		key:        key,
+		host:       request.URL.Host,
+		path:       request.URL.Path,
+		statusCode: response.StatusCode,
+		status:     response.Status,
+		proto:      response.Proto,
+		header:     response.Header.Clone(),
+		body:       body,
+		expires:    expires,
+		ttl:        ttl,
+	}
+	rc.put(entry)
+	response.Body = ioutil.NopCloser(bytes.NewReader(body))
+	response.Header.Set(CacheStatusHeader, "miss")
+	return response, nil
+}
+
+// uncached removes any entry for key and returns response, which was not cached
+func (rc *ResponseCache) uncached(key string, response *http.Response) *http.Response {
+	rc.remove(key)
+	response.Header.Set(CacheStatusHeader, "miss")
+	return response
+}
+
+// expires returns when a response with header stops being fresh, ttl after now if header does not specify,
+// or false if it should not be cached
+func (rc *ResponseCache) expires(header http.Header, ttl time.Duration) (time.Time, bool) {
+	now := rc.now()
+	validated := header.Get("ETag") != "" || header.Get("Last-Modified") != ""
+	cacheControl := header.Get("Cache-Control")
+	if hasDirective(cacheControl, "no-store") || hasDirective(cacheControl, "private") {
+		// a private response is for a single user and a ResponseCache may be shared
+		return time.Time{}, false
+	}
+	if hasDirective(cacheControl, "no-cache") {
+		return now, validated
+	}
+	if maxAge, ok := directiveValue(cacheControl, "max-age"); ok {
+		if seconds, err := strconv.Atoi(maxAge); err == nil {
+			if age, err := strconv.Atoi(header.Get("Age")); err == nil {
+				seconds -= age
+			}
+			return now.Add(time.Duration(seconds) * time.Second), seconds > 0 || validated
+		}
+	}
+	if expires := header.Get("Expires"); expires != "" {
+		t, err := http.ParseTime(expires)
+		if err != nil {
+			// an invalid Expires header means the response has already expired
+			return now, validated
+		}
+		return t, t.After(now) || validated
+	}
+	return now.Add(ttl), ttl > 0 || validated
+}
+
+// revalidated updates entry with the headers of a 304 Not Modified response, returning the updated entry
+func (rc *ResponseCache) revalidated(entry *cacheEntry, header http.Header) *cacheEntry {
+	updated := *entry
+	updated.header = entry.header.Clone()
+	for _, name := range []string{"Cache-Control", "Expires", "ETag", "Last-Modified", "Date", "Age"} {
+		if values := header.Values(name); len(values) > 0 {
+			updated.header[http.CanonicalHeaderKey(name)] = values
+		}
+	}
+	expires, ok := rc.expires(updated.header, entry.ttl)
+	if !ok {
+		rc.remove(entry.key)
+		return &updated
+	}
+	updated.expires = expires
+	rc.put(&updated)
+	return &updated
+}
+
+// response returns a response for request with the entry's status, headers and body
+func (e *cacheEntry) response(request *http.Request, status string) *http.Response {
+	header := e.header.Clone()
+	header.Set(CacheStatusHeader, status)
+	return &http.Response{
+		Status:        e.status,
+		StatusCode:    e.statusCode,
+		Proto:         e.proto,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       request,
+	}
+}
+
+func (rc *ResponseCache) get(key string) *cacheEntry {
+	rc.mux.Lock()
+	defer rc.mux.Unlock()
+	if el, ok := rc.entries[key]; ok {
+		rc.lru.MoveToFront(el)
+		return el.Value.(*cacheEntry)
+	}
+	return nil
+}
+
+// put adds or replaces the entry for entry.key, evicting the least recently used entries over the bounds
+func (rc *ResponseCache) put(entry *cacheEntry) {
+	rc.mux.Lock()
+	defer rc.mux.Unlock()
+	if el, ok := rc.entries[entry.key]; ok {
+		rc.removeElement(el)
+	}
+	rc.entries[entry.key] = rc.lru.PushFront(entry)
+	rc.size += int64(len(entry.body))
+	for rc.lru.Len() > rc.config.MaxEntries || rc.size > rc.config.MaxBytes {
+		rc.removeElement(rc.lru.Back())
+	}
+}
+
+func (rc *ResponseCache) remove(key string) {
+	rc.mux.Lock()
+	defer rc.mux.Unlock()
+	if el, ok := rc.entries[key]; ok {
+		rc.removeElement(el)
+	}
+}
+
+// removeElement removes el from the cache, rc.mux must be held
+func (rc *ResponseCache) removeElement(el *list.Element) {
+	entry := rc.lru.Remove(el).(*cacheEntry)
+	delete(rc.entries, entry.key)
+	rc.size -= int64(len(entry.body))
+}
+
+// invalidate removes the entries for path on host, its sub-resources and the collections containing it,
+// e.g. a DELETE of /tenant/catalog/v2/datasets/id invalidates GETs of that dataset and of the datasets list
+func (rc *ResponseCache) invalidate(host string, path string) {
+	path = strings.TrimSuffix(path, "/")
+	rc.mux.Lock()
+	defer rc.mux.Unlock()
+	for _, el := range rc.entries {
+		entry := el.Value.(*cacheEntry)
+		if entry.host != host {
+			continue
+		}
+		cached := strings.TrimSuffix(entry.path, "/")
+		if cached == path || strings.HasPrefix(cached, path+"/") || strings.HasPrefix(path, cached+"/") {
+			rc.removeElement(el)
+		}
+	}
+}
+
+// cacheKey returns the key of the responses to request: its URL and a hash of its credential, such that a
+// response is not returned to a client of a shared cache with other credentials
+func cacheKey(request *Request) string {
+	credential := sha256.Sum256([]byte(request.Header.Get("Authorization")))
+	return hex.EncodeToString(credential[:]) + " " + request.URL.String()
+}
+
+// serviceFromPath returns the service from a request path, e.g. "catalog" from /mytenant/catalog/v2/datasets
+func serviceFromPath(urlPath string) string {
+	segments := splitPath(urlPath)
+	if len(segments) < 2 {
+		return ""
+	}
+	return segments[1]
+}
+
+// hasDirective returns whether the Cache-Control header value has the given directive
+func hasDirective(cacheControl string, directive string) bool {
+	_, ok := directiveValue(cacheControl, directive)
+	return ok
+}
+
+// directiveValue returns the value of a directive of a Cache-Control header value, and whether it is present
+func directiveValue(cacheControl string, directive string) (string, bool) {
+	for _, d := range strings.Split(cacheControl, ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(d), "=")
+		if strings.EqualFold(name, directive) {
+			return strings.Trim(value, `"`), true
+		}
+	}
+	return "", false
+}
+
+// drain reads and closes the body of a response which is not returned
+func drain(response *http.Response) {
+	_, _ = io.Copy(ioutil.Discard, response.Body)
+	response.Body.Close()
+}
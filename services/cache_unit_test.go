@@ -0,0 +1,297 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package services
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/splunk/go-dependencies/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cacheRT responds to GETs with the body and headers set for the request path, responding 304 Not Modified
+// to requests with a matching If-None-Match header
+type cacheRT struct {
+	mux      sync.Mutex
+	bodies   map[string]string
+	headers  http.Header
+	requests []*http.Request
+}
+
+func (rt *cacheRT) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.mux.Lock()
+	defer rt.mux.Unlock()
+	rt.requests = append(rt.requests, req)
+	header := rt.headers.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	if etag := header.Get("ETag"); etag != "" && req.Header.Get("If-None-Match") == etag {
+		return &http.Response{Status: "304 Not Modified", StatusCode: 304, Header: header, Body: ioutil.NopCloser(strings.NewReader(""))}, nil
+	}
+	body := rt.bodies[req.URL.Path]
+	return &http.Response{Status: "200 OK", StatusCode: 200, Header: header, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+}
+
+func (rt *cacheRT) count() int {
+	rt.mux.Lock()
+	defer rt.mux.Unlock()
+	return len(rt.requests)
+}
+
+// catalogTTL keeps the responses of the catalog service fresh for a minute
+var catalogTTL = map[string]time.Duration{"catalog": time.Minute}
+
+// newCachingClient returns a client with cache which sends requests to rt
+func newCachingClient(t *testing.T, cache *ResponseCache, rt http.RoundTripper) *BaseClient {
+	client, err := NewClient(&Config{Token: "testtoken", Tenant: "mytenant", RoundTripper: rt, ResponseCache: cache})
+	require.NoError(t, err)
+	return client
+}
+
+// cachedGet makes a GET request to path and returns the body and cache status of the response
+func cachedGet(t *testing.T, client *BaseClient, path string) (string, string) {
+	u, err := client.BuildURLFromPathParams(nil, "api", path, nil)
+	require.NoError(t, err)
+	response, err := client.Get(services.RequestParams{URL: u})
+	require.NoError(t, err)
+	defer response.Body.Close()
+	body, err := ioutil.ReadAll(response.Body)
+	require.NoError(t, err)
+	return string(body), response.Header.Get(CacheStatusHeader)
+}
+
+func TestResponseCacheTTL(t *testing.T) {
+	rt := &cacheRT{bodies: map[string]string{"/mytenant/catalog/v2/datasets": `[{"name":"ds1"}]`}}
+	cache := NewResponseCache(ResponseCacheConfig{TTLs: catalogTTL})
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+	client := newCachingClient(t, cache, rt)
+
+	body, status := cachedGet(t, client, "/catalog/v2/datasets")
+	assert.Equal(t, `[{"name":"ds1"}]`, body)
+	assert.Equal(t, "miss", status)
+	body, status = cachedGet(t, client, "/catalog/v2/datasets")
+	assert.Equal(t, `[{"name":"ds1"}]`, body)
+	assert.Equal(t, "hit", status)
+	assert.Equal(t, 1, rt.count())
+
+	// Once stale, a response without validators is fetched again
+	now = now.Add(2 * time.Minute)
+	_, status = cachedGet(t, client, "/catalog/v2/datasets")
+	assert.Equal(t, "miss", status)
+	assert.Equal(t, 2, rt.count())
+}
+
+func TestResponseCacheNotCachedByDefault(t *testing.T) {
+	rt := &cacheRT{bodies: map[string]string{"/mytenant/catalog/v2/datasets": `[]`}}
+	cache := NewResponseCache(ResponseCacheConfig{})
+	client := newCachingClient(t, cache, rt)
+	cachedGet(t, client, "/catalog/v2/datasets")
+	cachedGet(t, client, "/catalog/v2/datasets")
+	assert.Equal(t, 2, rt.count())
+	assert.Equal(t, 0, cache.Len())
+
+	rt.headers = http.Header{"Cache-Control": {"max-age=60, no-store"}}
+	cachedGet(t, client, "/catalog/v2/datasets")
+	assert.Equal(t, 0, cache.Len(), "no-store responses should not be cached")
+}
+
+func TestResponseCacheRevalidation(t *testing.T) {
+	rt := &cacheRT{
+		bodies:  map[string]string{"/mytenant/catalog/v2/modules": `[{"name":"mod1"}]`},
+		headers: http.Header{"Etag": {`"v1"`}, "Cache-Control": {"max-age=30"}},
+	}
+	cache := NewResponseCache(ResponseCacheConfig{})
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+	client := newCachingClient(t, cache, rt)
+
+	cachedGet(t, client, "/catalog/v2/modules")
+	_, status := cachedGet(t, client, "/catalog/v2/modules")
+	assert.Equal(t, "hit", status)
+	require.Equal(t, 1, rt.count())
+
+	now = now.Add(time.Minute)
+	body, status := cachedGet(t, client, "/catalog/v2/modules")
+	assert.Equal(t, `[{"name":"mod1"}]`, body)
+	assert.Equal(t, "revalidated", status)
+	require.Equal(t, 2, rt.count())
+	assert.Equal(t, `"v1"`, rt.requests[1].Header.Get("If-None-Match"))
+
+	// The 304 response renewed the freshness of the cached response
+	_, status = cachedGet(t, client, "/catalog/v2/modules")
+	assert.Equal(t, "hit", status)
+	assert.Equal(t, 2, rt.count())
+
+	// A changed resource replaces the cached response
+	now = now.Add(time.Minute)
+	rt.headers.Set("ETag", `"v2"`)
+	rt.bodies["/mytenant/catalog/v2/modules"] = `[{"name":"mod2"}]`
+	body, status = cachedGet(t, client, "/catalog/v2/modules")
+	assert.Equal(t, `[{"name":"mod2"}]`, body)
+	assert.Equal(t, "miss", status)
+}
+
+func TestResponseCacheLastModified(t *testing.T) {
+	modified := time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat)
+	rt := &cacheRT{
+		bodies:  map[string]string{"/mytenant/action/v1beta2/actions": `[]`},
+		headers: http.Header{"Last-Modified": {modified}, "Cache-Control": {"no-cache"}},
+	}
+	client := newCachingClient(t, NewResponseCache(ResponseCacheConfig{}), rt)
+	cachedGet(t, client, "/action/v1beta2/actions")
+	cachedGet(t, client, "/action/v1beta2/actions")
+	require.Equal(t, 2, rt.count(), "no-cache responses should always be revalidated")
+	assert.Equal(t, modified, rt.requests[1].Header.Get("If-Modified-Since"))
+}
+
+func TestResponseCacheInvalidation(t *testing.T) {
+	rt := &cacheRT{bodies: map[string]string{
+		"/mytenant/catalog/v2/datasets":     `[{"id":"ds1"}]`,
+		"/mytenant/catalog/v2/datasets/ds1": `{"id":"ds1"}`,
+		"/mytenant/catalog/v2/modules":      `[]`,
+	}}
+	cache := NewResponseCache(ResponseCacheConfig{TTLs: catalogTTL})
+	client := newCachingClient(t, cache, rt)
+	for _, path := range []string{"/catalog/v2/datasets", "/catalog/v2/datasets/ds1", "/catalog/v2/modules"} {
+		cachedGet(t, client, path)
+	}
+	require.Equal(t, 3, cache.Len())
+
+	u, err := client.BuildURLFromPathParams(nil, "api", "/catalog/v2/datasets/ds1", nil)
+	require.NoError(t, err)
+	_, err = client.Delete(services.RequestParams{URL: u})
+	require.NoError(t, err)
+	// The dataset and the list containing it are invalidated, other resources are not
+	assert.Equal(t, 1, cache.Len())
+	_, status := cachedGet(t, client, "/catalog/v2/modules")
+	assert.Equal(t, "hit", status)
+	_, status = cachedGet(t, client, "/catalog/v2/datasets")
+	assert.Equal(t, "miss", status)
+
+	cache.Purge()
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestResponseCacheBounds(t *testing.T) {
+	rt := &cacheRT{bodies: map[string]string{
+		"/mytenant/catalog/v2/a":   "aaaa",
+		"/mytenant/catalog/v2/b":   "bbbb",
+		"/mytenant/catalog/v2/c":   "cccc",
+		"/mytenant/catalog/v2/big": strings.Repeat("x", 20),
+	}}
+	cache := NewResponseCache(ResponseCacheConfig{TTLs: catalogTTL, MaxEntries: 2, MaxBytes: 10})
+	client := newCachingClient(t, cache, rt)
+	cachedGet(t, client, "/catalog/v2/a")
+	cachedGet(t, client, "/catalog/v2/b")
+	cachedGet(t, client, "/catalog/v2/a")
+	cachedGet(t, client, "/catalog/v2/c")
+	// b was the least recently used
+	assert.Equal(t, 2, cache.Len())
+	_, status := cachedGet(t, client, "/catalog/v2/a")
+	assert.Equal(t, "hit", status)
+	_, status = cachedGet(t, client, "/catalog/v2/b")
+	assert.Equal(t, "miss", status)
+
+	// Bodies over MaxBytes are returned whole but not cached
+	body, _ := cachedGet(t, client, "/catalog/v2/big")
+	assert.Equal(t, strings.Repeat("x", 20), body)
+	_, status = cachedGet(t, client, "/catalog/v2/big")
+	assert.Equal(t, "miss", status)
+}
+
+func TestResponseCacheRequestNoCache(t *testing.T) {
+	rt := &cacheRT{bodies: map[string]string{"/mytenant/catalog/v2/datasets": `[]`}}
+	client := newCachingClient(t, NewResponseCache(ResponseCacheConfig{TTLs: catalogTTL}), rt)
+	cachedGet(t, client, "/catalog/v2/datasets")
+	u, err := client.BuildURLFromPathParams(nil, "api", "/catalog/v2/datasets", nil)
+	require.NoError(t, err)
+	response, err := client.Get(services.RequestParams{URL: u, Headers: map[string]string{"Cache-Control": "no-cache"}})
+	require.NoError(t, err)
+	response.Body.Close()
+	assert.Equal(t, 2, rt.count())
+}
+
+func TestResponseCacheSharedByCredential(t *testing.T) {
+	rt := &cacheRT{bodies: map[string]string{"/mytenant/catalog/v2/datasets": `[]`}}
+	cache := NewResponseCache(ResponseCacheConfig{TTLs: catalogTTL})
+	client := newCachingClient(t, cache, rt)
+	other, err := NewClient(&Config{Token: "othertoken", Tenant: "mytenant", RoundTripper: rt, ResponseCache: cache})
+	require.NoError(t, err)
+
+	_, status := cachedGet(t, client, "/catalog/v2/datasets")
+	assert.Equal(t, "miss", status)
+	// responses are not returned to clients with other credentials
+	_, status = cachedGet(t, other, "/catalog/v2/datasets")
+	assert.Equal(t, "miss", status)
+	_, status = cachedGet(t, client, "/catalog/v2/datasets")
+	assert.Equal(t, "hit", status)
+	_, status = cachedGet(t, other, "/catalog/v2/datasets")
+	assert.Equal(t, "hit", status)
+	assert.Equal(t, 2, rt.count())
+}
+
+func TestResponseCachePrivate(t *testing.T) {
+	for _, cacheControl := range []string{"private, max-age=60", "no-store"} {
+		rt := &cacheRT{
+			bodies:  map[string]string{"/mytenant/identity/v3/principals/me": `{}`},
+			headers: http.Header{"Cache-Control": {cacheControl}, "Etag": {`"v1"`}},
+		}
+		client := newCachingClient(t, NewResponseCache(ResponseCacheConfig{TTLs: catalogTTL}), rt)
+		cachedGet(t, client, "/identity/v3/principals/me")
+		_, status := cachedGet(t, client, "/identity/v3/principals/me")
+		assert.Equal(t, "miss", status, cacheControl)
+		assert.Equal(t, 2, rt.count(), cacheControl)
+		assert.Empty(t, rt.requests[1].Header.Get("If-None-Match"), cacheControl)
+	}
+}
+
+func TestResponseCacheTTLsPerService(t *testing.T) {
+	rt := &cacheRT{bodies: map[string]string{
+		"/mytenant/search/v2/jobs/sid1":        `{"status":"running"}`,
+		"/mytenant/search/v2/jobs/sid1/export": `{"host":"h1"}`,
+	}}
+	cache := NewResponseCache(ResponseCacheConfig{TTLs: map[string]time.Duration{"catalog": time.Minute, "search": time.Minute}})
+	client := newCachingClient(t, cache, rt)
+	other := newCachingClient(t, NewResponseCache(ResponseCacheConfig{TTLs: catalogTTL}), rt)
+
+	// responses of services without a TTL are not cached
+	cachedGet(t, other, "/search/v2/jobs/sid1")
+	_, status := cachedGet(t, other, "/search/v2/jobs/sid1")
+	assert.Equal(t, "miss", status)
+	assert.Equal(t, 2, rt.count())
+
+	// streamed responses are never cached
+	u, err := client.BuildURLFromPathParams(nil, "api", "/search/v2/jobs/sid1/export", nil)
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		response, err := client.DoRequestWithContext(WithStreamedResponse(context.Background()), services.RequestParams{Method: http.MethodGet, URL: u})
+		require.NoError(t, err)
+		response.Body.Close()
+		assert.Empty(t, response.Header.Get(CacheStatusHeader))
+	}
+	assert.Equal(t, 4, rt.count())
+	assert.Equal(t, 0, cache.Len())
+}
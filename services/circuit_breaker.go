@@ -0,0 +1,271 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// CircuitState is the state of a circuit breaker for a service host
+type CircuitState int
+
+const (
+	// CircuitClosed allows all requests, failures are counted
+	CircuitClosed CircuitState = iota
+	// CircuitOpen fails requests immediately with a *CircuitOpenError until the cooldown has elapsed
+	CircuitOpen
+	// CircuitHalfOpen allows a limited number of trial requests, a success closes the circuit and
+	// a failure opens it again
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return fmt.Sprintf("CircuitState(%d)", int(s))
+}
+
+const (
+	defaultFailureThreshold = 5
+	defaultCooldown         = 30 * time.Second
+	defaultHalfOpenRequests = 1
+)
+
+// ErrCircuitOpen is matched by errors.Is for the *CircuitOpenError returned for requests to a host whose circuit is open
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitOpenError is returned without sending the request when the circuit for the request's host is open
+type CircuitOpenError struct {
+	// Host is the service host that requests are failing for
+	Host string
+	// RetryAt is when the circuit will next allow a trial request
+	RetryAt time.Time
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker is open for %s until %s", e.Host, e.RetryAt.Format(time.RFC3339))
+}
+
+// Is returns true for ErrCircuitOpen
+func (e *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// CircuitBreakerConfig configures a CircuitBreaker, all fields are optional
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive failures for a host which opens its circuit. Default to 5
+	FailureThreshold int
+	// Cooldown is how long the circuit stays open before allowing trial requests. Default to 30 seconds
+	Cooldown time.Duration
+	// HalfOpenRequests is the number of trial requests allowed at a time while the circuit is half-open. Default to 1
+	HalfOpenRequests int
+	// IsFailure determines whether the outcome of a request counts as a failure, by default request errors and
+	// 5xx responses are failures. Requests whose context is done are never recorded, as the service did not answer
+	IsFailure func(request *Request, reqErr error, response *http.Response) bool
+	// OnStateChange (optional) is called when the circuit for a host changes state
+	OnStateChange func(host string, from CircuitState, to CircuitState)
+}
+
+// CircuitBreaker stops sending requests to a service host after repeated failures, giving a degraded
+// service time to recover rather than adding to its load and that of retries. Set Config.CircuitBreaker to
+// use it, the circuit breaker is both a RequestHandler, failing requests fast while open, and a
+// ResponseOrErrorHandler, recording the outcome of each attempt. It is also a RequestVetoHandler, releasing the
+// trial of a half-open circuit if a later RequestHandler vetoes the request:
+//
+//	config := &services.Config{
+//		CircuitBreaker: services.NewCircuitBreaker(services.CircuitBreakerConfig{FailureThreshold: 10}),
+//		...
+//	}
+type CircuitBreaker struct {
+	config   CircuitBreakerConfig
+	mux      sync.Mutex
+	circuits map[string]*circuit
+	now      func() time.Time
+}
+
+type circuit struct {
+	state    CircuitState
+	failures int
+	openedAt time.Time
+	trials   int
+}
+
+// NewCircuitBreaker creates a CircuitBreaker, all circuits start closed
+func NewCircuitBreaker(config CircuitBreakerConfig) *CircuitBreaker {
+	if config.FailureThreshold <= 0 {
+		config.FailureThreshold = defaultFailureThreshold
+	}
+	if config.Cooldown <= 0 {
+		config.Cooldown = defaultCooldown
+	}
+	if config.HalfOpenRequests <= 0 {
+		config.HalfOpenRequests = defaultHalfOpenRequests
+	}
+	if config.IsFailure == nil {
+		config.IsFailure = defaultIsFailure
+	}
+	return &CircuitBreaker{
+		config:   config,
+		circuits: make(map[string]*circuit),
+		now:      time.Now,
+	}
+}
+
+func defaultIsFailure(request *Request, reqErr error, response *http.Response) bool {
+	if reqErr != nil {
+		return true
+	}
+	return response != nil && response.StatusCode >= 500
+}
+
+// State returns the current state of the circuit for host
+func (cb *CircuitBreaker) State(host string) CircuitState {
+	cb.mux.Lock()
+	defer cb.mux.Unlock()
+	if c, ok := cb.circuits[host]; ok {
+		return c.state
+	}
+	return CircuitClosed
+}
+
+// HandleRequest returns a *CircuitOpenError if the circuit for the request's host is open
+func (cb *CircuitBreaker) HandleRequest(client *BaseClient, request *Request) error {
+	host := request.URL.Host
+	cb.mux.Lock()
+	c := cb.circuit(host)
+	var transition func()
+	if c.state == CircuitOpen && !cb.now().Before(c.openedAt.Add(cb.config.Cooldown)) {
+		c.trials = 0
+		transition = cb.setState(host, c, CircuitHalfOpen)
+	}
+	var err error
+	switch c.state {
+	case CircuitOpen:
+		err = &CircuitOpenError{Host: host, RetryAt: c.openedAt.Add(cb.config.Cooldown)}
+	case CircuitHalfOpen:
+		if c.trials >= cb.config.HalfOpenRequests {
+			err = &CircuitOpenError{Host: host, RetryAt: cb.now()}
+		} else {
+			c.trials++
+		}
+	}
+	cb.mux.Unlock()
+	if transition != nil {
+		transition()
+	}
+	return err
+}
+
+// HandleRequestVeto releases the trial taken by the request if the circuit is half-open
+func (cb *CircuitBreaker) HandleRequestVeto(client *BaseClient, request *Request, err error) {
+	cb.mux.Lock()
+	cb.releaseTrial(cb.circuit(request.URL.Host))
+	cb.mux.Unlock()
+}
+
+// releaseTrial releases a trial request of c if it is half-open, cb.mux must be held
+func (cb *CircuitBreaker) releaseTrial(c *circuit) {
+	if c.state == CircuitHalfOpen && c.trials > 0 {
+		c.trials--
+	}
+}
+
+// HandleResponse records the outcome of the request and returns the response unchanged
+func (cb *CircuitBreaker) HandleResponse(client *BaseClient, request *Request, response *http.Response) (*http.Response, error) {
+	cb.record(request, nil, response)
+	return response, nil
+}
+
+// HandleRequestError records the outcome of the request and returns the error unchanged
+func (cb *CircuitBreaker) HandleRequestError(client *BaseClient, request *Request, err error) (*http.Response, error) {
+	cb.record(request, err, nil)
+	return nil, err
+}
+
+func (cb *CircuitBreaker) record(request *Request, reqErr error, response *http.Response) {
+	if request == nil {
+		return
+	}
+	host := request.URL.Host
+	if errors.Is(reqErr, context.Canceled) || errors.Is(reqErr, context.DeadlineExceeded) {
+		// requests abandoned by the caller say nothing about the health of the service
+		cb.mux.Lock()
+		cb.releaseTrial(cb.circuit(host))
+		cb.mux.Unlock()
+		return
+	}
+	failed := cb.config.IsFailure(request, reqErr, response)
+	cb.mux.Lock()
+	c := cb.circuit(host)
+	var transition func()
+	switch {
+	case !failed:
+		c.failures = 0
+		if c.state != CircuitClosed {
+			transition = cb.setState(host, c, CircuitClosed)
+		}
+	case c.state == CircuitHalfOpen:
+		transition = cb.open(host, c)
+	case c.state == CircuitClosed:
+		c.failures++
+		if c.failures >= cb.config.FailureThreshold {
+			transition = cb.open(host, c)
+		}
+	}
+	cb.mux.Unlock()
+	if transition != nil {
+		transition()
+	}
+}
+
+// circuit returns the circuit for host, creating it if needed, cb.mux must be held
+func (cb *CircuitBreaker) circuit(host string) *circuit {
+	c, ok := cb.circuits[host]
+	if !ok {
+		c = &circuit{}
+		cb.circuits[host] = c
+	}
+	return c
+}
+
+// open opens the circuit c, cb.mux must be held
+func (cb *CircuitBreaker) open(host string, c *circuit) func() {
+	c.openedAt = cb.now()
+	c.failures = 0
+	return cb.setState(host, c, CircuitOpen)
+}
+
+// setState changes the state of c and returns a func to notify OnStateChange once cb.mux has been released
+func (cb *CircuitBreaker) setState(host string, c *circuit, state CircuitState) func() {
+	from := c.state
+	c.state = state
+	if cb.config.OnStateChange == nil {
+		return nil
+	}
+	return func() { cb.config.OnStateChange(host, from, state) }
+}
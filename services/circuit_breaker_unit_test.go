@@ -0,0 +1,200 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/splunk/go-dependencies/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// switchRT responds with StatusCode, counting requests by host
+type switchRT struct {
+	StatusCode int
+	N          map[string]int
+}
+
+func (rt *switchRT) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.N[req.URL.Host]++
+	b := ioutil.NopCloser(bytes.NewReader([]byte("")))
+	return &http.Response{Status: fmt.Sprintf("%d %s", rt.StatusCode, http.StatusText(rt.StatusCode)), StatusCode: rt.StatusCode, Body: b}, nil
+}
+
+type stateChange struct {
+	host     string
+	from, to CircuitState
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	var changes []stateChange
+	breaker := NewCircuitBreaker(CircuitBreakerConfig{
+		FailureThreshold: 3,
+		Cooldown:         time.Minute,
+		OnStateChange: func(host string, from CircuitState, to CircuitState) {
+			changes = append(changes, stateChange{host, from, to})
+		},
+	})
+	now := time.Now()
+	breaker.now = func() time.Time { return now }
+	rt := &switchRT{StatusCode: 503, N: make(map[string]int)}
+	client, err := NewClient(&Config{
+		Token:          "testtoken",
+		Tenant:         "mytenant",
+		CircuitBreaker: breaker,
+		RoundTripper:   rt,
+	})
+	require.Nil(t, err, "Error calling NewClient(): %s", err)
+	streams, err := client.BuildURL(nil, "api", "streams")
+	require.NoError(t, err)
+	other, err := client.BuildURL(nil, "app", "other")
+	require.NoError(t, err)
+	require.NotEqual(t, streams.Host, other.Host)
+
+	for i := 0; i < 5; i++ {
+		_, err = client.Get(services.RequestParams{URL: streams})
+		require.Error(t, err)
+	}
+	assert.Equal(t, 3, rt.N[streams.Host], "requests should fail fast once the circuit is open")
+	assert.True(t, errors.Is(err, ErrCircuitOpen))
+	var openErr *CircuitOpenError
+	require.True(t, errors.As(err, &openErr))
+	assert.Equal(t, streams.Host, openErr.Host)
+	assert.Equal(t, now.Add(time.Minute), openErr.RetryAt)
+	assert.Equal(t, CircuitOpen, breaker.State(streams.Host))
+
+	// other hosts are unaffected
+	rt.StatusCode = 200
+	_, err = client.Get(services.RequestParams{URL: other})
+	require.NoError(t, err)
+	assert.Equal(t, CircuitClosed, breaker.State(other.Host))
+
+	// after the cooldown a failed trial request opens the circuit again
+	rt.StatusCode = 503
+	now = now.Add(time.Minute)
+	_, err = client.Get(services.RequestParams{URL: streams})
+	require.Error(t, err)
+	assert.False(t, errors.Is(err, ErrCircuitOpen))
+	assert.Equal(t, 4, rt.N[streams.Host])
+	assert.Equal(t, CircuitOpen, breaker.State(streams.Host))
+
+	// and a successful trial request closes it
+	rt.StatusCode = 200
+	now = now.Add(time.Minute)
+	_, err = client.Get(services.RequestParams{URL: streams})
+	require.NoError(t, err)
+	assert.Equal(t, CircuitClosed, breaker.State(streams.Host))
+
+	assert.Equal(t, []stateChange{
+		{streams.Host, CircuitClosed, CircuitOpen},
+		{streams.Host, CircuitOpen, CircuitHalfOpen},
+		{streams.Host, CircuitHalfOpen, CircuitOpen},
+		{streams.Host, CircuitOpen, CircuitHalfOpen},
+		{streams.Host, CircuitHalfOpen, CircuitClosed},
+	}, changes)
+}
+
+func TestCircuitBreakerWithRetries(t *testing.T) {
+	breaker := NewCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 2})
+	rt := &statusRT{Statuses: []int{503, 503, 503}}
+	client, err := NewClient(&Config{
+		Token:          "testtoken",
+		RetryRequests:  true,
+		CircuitBreaker: breaker,
+		RetryConfig: RetryStrategyConfig{
+			ConfigurableRetryConfig: &ConfigurableRetryConfig{
+				RetryNum:          4,
+				Interval:          1,
+				RetryServerErrors: true,
+			},
+		},
+		RoundTripper: rt,
+	})
+	require.Nil(t, err, "Error calling NewClient(): %s", err)
+	_, err = client.Get(services.RequestParams{})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrCircuitOpen), "retries should stop once the circuit opens")
+	assert.Equal(t, 2, rt.N)
+}
+
+// cancelRT fails requests as if their context was cancelled while Cancel is set, else responds like switchRT
+type cancelRT struct {
+	switchRT
+	Cancel bool
+}
+
+func (rt *cancelRT) RoundTrip(req *http.Request) (*http.Response, error) {
+	if rt.Cancel {
+		return nil, context.Canceled
+	}
+	return rt.switchRT.RoundTrip(req)
+}
+
+func TestCircuitBreakerAbandonedTrials(t *testing.T) {
+	breaker := NewCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 1, Cooldown: time.Minute})
+	now := time.Now()
+	breaker.now = func() time.Time { return now }
+	rt := &cancelRT{switchRT: switchRT{StatusCode: 503, N: make(map[string]int)}}
+	veto := errors.New("vetoed")
+	vetoing := false
+	client, err := NewClient(&Config{
+		Token:          "testtoken",
+		Tenant:         "mytenant",
+		CircuitBreaker: breaker,
+		RoundTripper:   rt,
+		RequestHandlers: []RequestHandler{RequestHandlerFunc(func(client *BaseClient, request *Request) error {
+			if vetoing {
+				return veto
+			}
+			return nil
+		})},
+	})
+	require.NoError(t, err)
+	streams, err := client.BuildURL(nil, "api", "streams")
+	require.NoError(t, err)
+	_, err = client.Get(services.RequestParams{URL: streams})
+	require.Error(t, err)
+	assert.Equal(t, CircuitOpen, breaker.State(streams.Host))
+	now = now.Add(time.Minute)
+
+	// a trial vetoed by a later request handler is released
+	vetoing = true
+	_, err = client.Get(services.RequestParams{URL: streams})
+	assert.Equal(t, veto, err)
+	assert.Equal(t, CircuitHalfOpen, breaker.State(streams.Host))
+	vetoing = false
+
+	// a cancelled trial is not recorded as a success, and is released
+	rt.Cancel = true
+	_, err = client.Get(services.RequestParams{URL: streams})
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Equal(t, CircuitHalfOpen, breaker.State(streams.Host))
+
+	rt.Cancel = false
+	rt.StatusCode = 200
+	_, err = client.Get(services.RequestParams{URL: streams})
+	require.NoError(t, err)
+	assert.Equal(t, CircuitClosed, breaker.State(streams.Host))
+}
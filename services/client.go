@@ -22,6 +22,7 @@ package services
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -32,6 +33,7 @@ import (
 	"path"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"text/template"
 	"time"
 
@@ -40,18 +42,54 @@ import (
 	"github.com/splunk/splunk-cloud-sdk-go/util"
 )
 
-//go:generate go run ../util/gen_interface.go -svc=action -p=action -sf=service_generated.go -sf=service_sdk.go
-//go:generate go run ../util/gen_interface.go -svc=appregistry -p=appregistry -sf=service_generated.go
-//go:generate go run ../util/gen_interface.go -svc=catalog -p=catalog -sf=service_generated.go
-//go:generate go run ../util/gen_interface.go -svc=collect -p=collect -sf=service_generated.go
-//go:generate go run ../util/gen_interface.go -svc=forwarders -p=forwarders -sf=service_generated.go
-//go:generate go run ../util/gen_interface.go -svc=identity -p=identity -sf=service_generated.go
-//go:generate go run ../util/gen_interface.go -svc=ingest -p=ingest -sf=new_batch_events_sender.go -sf=service_generated.go
-//go:generate go run ../util/gen_interface.go -svc=kvstore -p=kvstore -sf=service_generated.go
-//go:generate go run ../util/gen_interface.go -svc=ml -p=ml -sf=service_generated.go
-//go:generate go run ../util/gen_interface.go -svc=search -p=search -sf=service.go -sf=service_generated.go
-//go:generate go run ../util/gen_interface.go -svc=streams -p=streams -sf=service_generated.go
-//go:generate go run ../util/gen_interface.go -svc=provisioner -p=provisioner -sf=service_generated.go
+//go:generate go run ../util/gen_context.go -svc=action -sf=service_generated.go
+//go:generate go run ../util/gen_context.go -svc=appregistry -sf=service_generated.go
+//go:generate go run ../util/gen_context.go -svc=catalog -sf=service_generated.go
+//go:generate go run ../util/gen_context.go -svc=collect -sf=service_generated.go
+//go:generate go run ../util/gen_context.go -svc=forwarders -sf=service_generated.go
+//go:generate go run ../util/gen_context.go -svc=identity -sf=service_generated.go
+//go:generate go run ../util/gen_context.go -svc=ingest -sf=service_generated.go
+//go:generate go run ../util/gen_context.go -svc=kvstore -sf=service_generated.go
+//go:generate go run ../util/gen_context.go -svc=ml -sf=service_generated.go
+//go:generate go run ../util/gen_context.go -svc=search -sf=service_generated.go
+//go:generate go run ../util/gen_context.go -svc=streams -sf=service_generated.go
+//go:generate go run ../util/gen_context.go -svc=provisioner -sf=service_generated.go
+//go:generate go run ../util/gen_pager.go -svc=action
+//go:generate go run ../util/gen_pager.go -svc=appregistry
+//go:generate go run ../util/gen_pager.go -svc=catalog
+//go:generate go run ../util/gen_pager.go -svc=collect
+//go:generate go run ../util/gen_pager.go -svc=forwarders
+//go:generate go run ../util/gen_pager.go -svc=identity
+//go:generate go run ../util/gen_pager.go -svc=ingest
+//go:generate go run ../util/gen_pager.go -svc=kvstore
+//go:generate go run ../util/gen_pager.go -svc=ml
+//go:generate go run ../util/gen_pager.go -svc=search
+//go:generate go run ../util/gen_pager.go -svc=streams
+//go:generate go run ../util/gen_pager.go -svc=provisioner
+//go:generate go run ../util/gen_interface.go -svc=action -p=action -sf=service_generated.go -sf=service_sdk.go -sf=service_context_generated.go
+//go:generate go run ../util/gen_interface.go -svc=appregistry -p=appregistry -sf=service_generated.go -sf=service_context_generated.go
+//go:generate go run ../util/gen_interface.go -svc=catalog -p=catalog -sf=service_generated.go -sf=service_context_generated.go
+//go:generate go run ../util/gen_interface.go -svc=collect -p=collect -sf=service_generated.go -sf=service_context_generated.go
+//go:generate go run ../util/gen_interface.go -svc=forwarders -p=forwarders -sf=service_generated.go -sf=service_context_generated.go
+//go:generate go run ../util/gen_interface.go -svc=identity -p=identity -sf=service_generated.go -sf=service_context_generated.go
+//go:generate go run ../util/gen_interface.go -svc=ingest -p=ingest -sf=new_batch_events_sender.go -sf=service_generated.go -sf=service_context_generated.go
+//go:generate go run ../util/gen_interface.go -svc=kvstore -p=kvstore -sf=service_generated.go -sf=service_context_generated.go
+//go:generate go run ../util/gen_interface.go -svc=ml -p=ml -sf=service_generated.go -sf=service_context_generated.go
+//go:generate go run ../util/gen_interface.go -svc=search -p=search -sf=service.go -sf=service_generated.go -sf=service_context_generated.go
+//go:generate go run ../util/gen_interface.go -svc=streams -p=streams -sf=service_generated.go -sf=service_context_generated.go
+//go:generate go run ../util/gen_interface.go -svc=provisioner -p=provisioner -sf=service_generated.go -sf=service_context_generated.go
+//go:generate go run ../util/gen_interface.go -svc=action -mock
+//go:generate go run ../util/gen_interface.go -svc=appregistry -mock
+//go:generate go run ../util/gen_interface.go -svc=catalog -mock
+//go:generate go run ../util/gen_interface.go -svc=collect -mock
+//go:generate go run ../util/gen_interface.go -svc=forwarders -mock
+//go:generate go run ../util/gen_interface.go -svc=identity -mock
+//go:generate go run ../util/gen_interface.go -svc=ingest -mock
+//go:generate go run ../util/gen_interface.go -svc=kvstore -mock
+//go:generate go run ../util/gen_interface.go -svc=ml -mock
+//go:generate go run ../util/gen_interface.go -svc=search -mock
+//go:generate go run ../util/gen_interface.go -svc=streams -mock
+//go:generate go run ../util/gen_interface.go -svc=provisioner -mock
 
 // Declare constants for service package
 const (
@@ -60,6 +98,8 @@ const (
 
 // A BaseClient for communicating with Splunk Cloud
 type BaseClient struct {
+	// settingsMux guards defaultTenant, overrideHost and region, which may be updated while requests are being made
+	settingsMux sync.RWMutex
 	// defaultTenant is the Splunk Cloud tenant to use to form requests
 	defaultTenant string
 	// rootDomain is the Splunk Cloud rootDomain or rootDomain:port used to form requests, `"scp.splunk.com"` by default.
@@ -70,23 +110,46 @@ type BaseClient struct {
 	// scheme is the HTTP scheme used to form requests, `"https"` by default
 	scheme string
 	// tokenContext is the access token to include in `"Authorization: Bearer"` headers and related context information
-	tokenContext *idp.Context
+	tokenContext atomic.Pointer[idp.Context]
 	// HTTP Client used to interact with endpoints
 	httpClient *http.Client
+	// streamHTTPClient is used for requests whose responses are streamed, it has no overall timeout as that
+	// would also limit reading the response body
+	streamHTTPClient *http.Client
+	// requestHandlers is a slice of handlers to call before a request is sent by the client
+	requestHandlers []RequestHandler
 	// responseHandlers is a slice of handlers to call after a response has been received in the client
 	responseHandlers []ResponseHandler
 	// tokenRetriever to gather access tokens to be sent in the Authorization: Bearer header on client initialization and upon encountering an expired token
 	tokenRetriever idp.TokenRetriever
 	// tokenExpireWindow is the (optional) window within which a new token gets retrieved before the existing token expires. Default to 1 minute
 	tokenExpireWindow time.Duration
-	// tokenMux is used to lock resource when a new token is being fetched
+	// tokenMux guards tokenRefresh and serializes updates to tokenContext
 	tokenMux sync.Mutex
+	// tokenRefresh is the token retrieval in progress, if any
+	tokenRefresh *tokenRefresh
+	// renewStop is closed by Close to stop background token renewal, nil if background renewal is not enabled
+	renewStop chan struct{}
+	// renewDone is closed when background token renewal has stopped
+	renewDone chan struct{}
+	// closeOnce ensures Close only stops background token renewal once
+	closeOnce sync.Once
 	// clientVersion contains the client name and its current version in string format
 	clientVersion string
 	//tenantScoped is bool True if the hostnames are scoped to a specific tenant/region
 	tenantScoped bool
 	//region is the name of the region that the tenant is contained in
 	region string
+	// tracer observes requests made by the client
+	tracer Tracer
+	// streamRequestBodies if true encodes request bodies as they are sent
+	streamRequestBodies bool
+	// gzipRequestBodies if true compresses JSON request bodies with gzip
+	gzipRequestBodies bool
+	// responseCache caches the responses of GET requests, nil if caching is not enabled
+	responseCache *ResponseCache
+	// dryRun is whether requests are resolved but not sent
+	dryRun bool
 }
 
 // Request extends net/http.Request to track number of total attempts and error
@@ -95,6 +158,19 @@ type Request struct {
 	*http.Request
 	NumAttempts     uint
 	NumErrorsByType map[string]uint
+	// Operation identifies the service endpoint being called, Operation.Name is empty for requests
+	// to endpoints which have not been registered using RegisterOperations
+	Operation Operation
+	// created is when the request was created, used to bound the total time spent retrying
+	created time.Time
+}
+
+// elapsed returns the time since the request was created
+func (r *Request) elapsed() time.Duration {
+	if r.created.IsZero() {
+		return 0
+	}
+	return time.Since(r.created)
 }
 
 // GetNumErrorsByResponseCode returns number of attempts for a given response code >= 400
@@ -137,8 +213,12 @@ type Config struct {
 	OverrideHost string
 	// Scheme is the (optional) default HTTP Scheme used to form requests, `"https"` by default
 	Scheme string
-	// Timeout is the (optional) default request-level timeout to use, 5 seconds by default
+	// Timeout is the (optional) default request-level timeout to use, 5 seconds by default. It does not apply to
+	// requests whose responses are streamed, see WithStreamedResponse
 	Timeout time.Duration
+	// RequestHandlers is an (optional) slice of handlers to call, in order, before each attempt of a
+	// request is sent - handlers may modify the request or return an error to prevent it being sent
+	RequestHandlers []RequestHandler
 	// ResponseHandlers is an (optional) slice of handlers to call after a response has been
 	// received in the client - handlers can optionally implement the ResponseOrErrorHandler
 	// interface as well for handling request errors as well as responses
@@ -147,10 +227,37 @@ type Config struct {
 	RetryRequests bool
 	// RetryStrategyConfig
 	RetryConfig RetryStrategyConfig
+	// RateLimits (optional) limits the rate of requests sent to each service and tenant
+	RateLimits RateLimitConfig
+	// StreamRequestBodies if true encodes JSON request bodies and multipart uploads of seekable streams (such as
+	// files) into requests as they are sent, rather than copying the whole body into memory first. Bodies are
+	// encoded again for each retry.
+	StreamRequestBodies bool
+	// GzipRequestBodies if true compresses JSON request bodies with gzip, setting `"Content-Encoding: gzip"`
+	GzipRequestBodies bool
+	// Tracer (optional) observes requests made by the client, e.g. to record traces and metrics
+	Tracer Tracer
+	// CircuitBreaker (optional) fails requests fast to hosts which are failing repeatedly, it is called before
+	// RequestHandlers and before any retry or ResponseHandlers so that it records the outcome of every attempt
+	CircuitBreaker *CircuitBreaker
+	// ResponseCache (optional) caches the responses of GET requests, revalidating them with the service once
+	// stale, and is invalidated by other requests to the same resources, see ResponseCacheConfig.TTLs
+	ResponseCache *ResponseCache
+	// DryRun (optional) if set, service calls return a DryRunError holding the resolved request rather than
+	// sending it, see DryRun and WithDryRun
+	DryRun bool
 	// RoundTripper
 	RoundTripper http.RoundTripper
+	// Transport (optional) configures client certificates, root CAs, proxies and connection pooling for requests,
+	// it is also used by TokenRetriever if that retrieves tokens using an idp.Client. Not valid with RoundTripper,
+	// wrap a transport created by util.NewTransport instead.
+	Transport *util.TransportConfig
 	// TokenExpireWindow is the (optional) window within which a new token gets retreieved before the existing token expires. Default to 1 minute
 	TokenExpireWindow time.Duration
+	// RenewTokensInBackground if true renews the access token in a background goroutine a TokenExpireWindow before
+	// requests would otherwise need to renew it, so that requests do not wait for the token to be retrieved.
+	// Call Close on the client to stop the goroutine.
+	RenewTokensInBackground bool
 	// ClientVersion contains the client name and its current version in string format
 	ClientVersion string
 	// TenantScoped is bool True if the hostnames are scoped to a specific tenant/region
@@ -161,12 +268,17 @@ type Config struct {
 
 // NewRequest creates a new HTTP Request and set proper header
 func (c *BaseClient) NewRequest(httpMethod, url string, body io.Reader, headers map[string]string) (*Request, error) {
-	request, err := http.NewRequest(httpMethod, url, body)
+	return c.NewRequestWithContext(context.Background(), httpMethod, url, body, headers)
+}
+
+// NewRequestWithContext creates a new HTTP Request bound to ctx and set proper header
+func (c *BaseClient) NewRequestWithContext(ctx context.Context, httpMethod, url string, body io.Reader, headers map[string]string) (*Request, error) {
+	request, err := http.NewRequestWithContext(ctx, httpMethod, url, body)
 	if err != nil {
 		return nil, err
 	}
-	if c.tokenContext != nil && len(c.tokenContext.AccessToken) > 0 {
-		request.Header.Set("Authorization", fmt.Sprintf("%s %s", AuthorizationType, c.tokenContext.AccessToken))
+	if tctx := c.tokenContext.Load(); tctx != nil && len(tctx.AccessToken) > 0 {
+		request.Header.Set("Authorization", fmt.Sprintf("%s %s", AuthorizationType, tctx.AccessToken))
 	}
 
 	httpSplunkClient := fmt.Sprintf("%s/%s", UserAgent, Version)
@@ -181,7 +293,10 @@ func (c *BaseClient) NewRequest(httpMethod, url string, body io.Reader, headers
 			request.Header.Set(key, value)
 		}
 	}
-	retryRequest := &Request{request, 0, make(map[string]uint)}
+	op, _ := MatchOperation(httpMethod, request.URL.Path)
+	retryRequest := &Request{Request: request, NumErrorsByType: make(map[string]uint), Operation: op, created: time.Now()}
+	// expose the attempt number to RoundTrippers such as util.LogTransport
+	retryRequest.Request = request.WithContext(util.WithAttemptCounter(ctx, &retryRequest.NumAttempts))
 	return retryRequest, nil
 }
 
@@ -191,8 +306,11 @@ func (c *BaseClient) BuildHost(serviceCluster string, appendToHost string) strin
 		appendToHost = appendToHost + "."
 	}
 	// If overrideHost is specified, always use that
-	if c.overrideHost != "" {
-		return c.overrideHost
+	c.settingsMux.RLock()
+	overrideHost := c.overrideHost
+	c.settingsMux.RUnlock()
+	if overrideHost != "" {
+		return overrideHost
 	}
 	// Otherwise form using <serviceCluster>.<rootDomain>
 	if serviceCluster != "" {
@@ -204,7 +322,15 @@ func (c *BaseClient) BuildHost(serviceCluster string, appendToHost string) strin
 
 // BuildURL creates full Splunk Cloud URL using the client's defaultTenant
 func (c *BaseClient) BuildURL(queryValues url.Values, serviceCluster string, urlPathParts ...string) (url.URL, error) {
-	return c.BuildURLWithTenant(c.defaultTenant, c.tenantScoped, c.region, queryValues, serviceCluster, urlPathParts...)
+	tenant, region := c.tenantAndRegion()
+	return c.BuildURLWithTenant(tenant, c.tenantScoped, region, queryValues, serviceCluster, urlPathParts...)
+}
+
+// tenantAndRegion returns the client's default tenant and region
+func (c *BaseClient) tenantAndRegion() (string, string) {
+	c.settingsMux.RLock()
+	defer c.settingsMux.RUnlock()
+	return c.defaultTenant, c.region
 }
 
 // BuildURLWithTenant creates full Splunk Cloud URL with tenant
@@ -237,6 +363,11 @@ func (c *BaseClient) BuildURLWithTenant(tenant string, tenantScoped bool, region
 
 // BuildURLFromPathParams creates full Splunk Cloud URL from path template and path params
 func (c *BaseClient) BuildURLFromPathParams(queryValues url.Values, serviceCluster string, templ string, pathParams interface{}) (url.URL, error) {
+	tenant, region := c.tenantAndRegion()
+	return c.buildURLFromPathParams(tenant, region, queryValues, serviceCluster, templ, pathParams)
+}
+
+func (c *BaseClient) buildURLFromPathParams(tenant string, region string, queryValues url.Values, serviceCluster string, templ string, pathParams interface{}) (url.URL, error) {
 	var u url.URL
 	t, err := template.New("path").Parse(templ)
 	if err != nil {
@@ -250,21 +381,21 @@ func (c *BaseClient) BuildURLFromPathParams(queryValues url.Values, serviceClust
 	path := buf.String()
 	if !strings.HasPrefix(path, "/system/") {
 		// for non-system-namespace endpoints, add tenant namespace
-		path = "/" + c.defaultTenant + path
+		path = "/" + tenant + path
 	}
 	if queryValues == nil {
 		queryValues = url.Values{}
 	}
 	appendToHost := ""
 	// Enforce that region must be specified
-	if c.tenantScoped == true && c.region == "" && strings.HasPrefix(path, "/system/") {
+	if c.tenantScoped == true && region == "" && strings.HasPrefix(path, "/system/") {
 		return u, errors.New("region cannot be empty")
 	}
 
-	if c.tenantScoped == true && c.region != "" && strings.HasPrefix(path, "/system/") {
-		appendToHost = "region-" + c.region
+	if c.tenantScoped == true && region != "" && strings.HasPrefix(path, "/system/") {
+		appendToHost = "region-" + region
 	} else if c.tenantScoped == true && !strings.HasPrefix(path, "/system/") {
-		appendToHost = c.defaultTenant
+		appendToHost = tenant
 	}
 	host := c.BuildHost(serviceCluster, appendToHost)
 	u = url.URL{
@@ -276,10 +407,31 @@ func (c *BaseClient) BuildURLFromPathParams(queryValues url.Values, serviceClust
 	return u, nil
 }
 
-// Do sends out request and returns HTTP response
+// Do sends out request and returns HTTP response, the request is bound to the context it was created with
 func (c *BaseClient) Do(req *Request) (*http.Response, error) {
 	req.NumAttempts++
-	response, err := c.httpClient.Do(req.Request)
+	for i, rh := range c.requestHandlers {
+		// A request handler returning an error vetoes the request, response handlers are not called
+		if err := rh.HandleRequest(c, req); err != nil {
+			for _, allowed := range c.requestHandlers[:i] {
+				if vh, ok := allowed.(RequestVetoHandler); ok {
+					vh.HandleRequestVeto(c, req, err)
+				}
+			}
+			// The body is not sent, close it as the transport would have, e.g. to stop a streaming body's writer
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, err
+		}
+	}
+	httpClient := c.httpClient
+	if isStreamedResponse(req.Context()) {
+		httpClient = c.streamHTTPClient
+	}
+	end := c.tracer.StartAttempt(req)
+	response, err := httpClient.Do(req.Request)
+	end(response, err)
 	if len(c.responseHandlers) == 0 {
 		// Return immediately if no error/response handling provided
 		return response, err
@@ -350,31 +502,61 @@ func (c *BaseClient) Patch(requestParams gdepservices.RequestParams) (*http.Resp
 
 // DoRequest creates and execute a new request
 func (c *BaseClient) DoRequest(requestParams gdepservices.RequestParams) (*http.Response, error) {
+	return c.DoRequestWithContext(context.Background(), requestParams)
+}
+
+// DoRequestWithContext creates and execute a new request bound to ctx, ctx is also used for any token
+// renewal and retry backoff needed to complete the request
+func (c *BaseClient) DoRequestWithContext(ctx context.Context, requestParams gdepservices.RequestParams) (*http.Response, error) {
+	op, _ := MatchOperation(requestParams.Method, requestParams.URL.Path)
+	ctx, end := c.tracer.StartRequest(ctx, op, tenantFromPath(requestParams.URL.Path))
+	response, err := c.doRequest(ctx, requestParams)
+	end(response, err)
+	return response, err
+}
+
+func (c *BaseClient) doRequest(ctx context.Context, requestParams gdepservices.RequestParams) (*http.Response, error) {
+	if c.isDryRun(ctx) {
+		return nil, c.dryRunRequest(ctx, requestParams)
+	}
 	var request *Request
 	var err error
-	now := time.Now().Add(c.tokenExpireWindow)
-	curEpoch := now.Unix()
-	// renew token if it's about to expire
-	if curEpoch >= c.tokenContext.StartTime+int64(c.tokenContext.ExpiresIn) {
-		c.tokenMux.Lock()
-		ctx, err := c.tokenRetriever.GetTokenContext()
-		if err != nil {
-			c.tokenMux.Unlock()
+	// renew token if it's about to expire, requests made at the same time share a single renewal
+	if tctx := c.tokenContext.Load(); c.tokenExpiring(tctx) {
+		staleToken := ""
+		if tctx != nil {
+			staleToken = tctx.AccessToken
+		}
+		if _, err := c.refreshToken(ctx, c.tokenRetriever, staleToken); err != nil {
 			return nil, err
 		}
-		// Update the client such that future requests will use the new access token and retain context information
-		c.UpdateTokenContext(ctx)
-		c.tokenMux.Unlock()
 	}
 
 	if len(requestParams.Headers) > 0 && requestParams.Headers["Content-Type"] == "multipart/form-data" {
-		request, err = c.makeFormRequest(requestParams)
+		request, err = c.makeFormRequest(ctx, requestParams)
+		if err != nil {
+			return nil, err
+		}
+
+	} else if body, ok := requestParams.Body.(ReplayableBody); ok {
+		request, err = c.newReplayableRequest(ctx, requestParams, body)
 		if err != nil {
 			return nil, err
 		}
 
+	} else if _, isBytes := requestParams.Body.([]byte); requestParams.Body != nil && !isBytes && c.streamRequestBodies {
+		body := &jsonBody{value: requestParams.Body, method: requestParams.Method, gzip: c.gzipRequestBodies}
+		request, err = c.newReplayableRequest(ctx, requestParams, body)
+		if err != nil {
+			return nil, err
+		}
+		if body.gzip {
+			request.Header.Set("Content-Encoding", "gzip")
+		}
+
 	} else if requestParams.Body != nil {
 		var buffer *bytes.Buffer
+		gzipped := false
 
 		if contentBytes, ok := requestParams.Body.([]byte); ok {
 			buffer = bytes.NewBuffer(contentBytes)
@@ -391,33 +573,85 @@ func (c *BaseClient) DoRequest(requestParams gdepservices.RequestParams) (*http.
 				return nil, marshalErr
 			}
 			buffer = bytes.NewBuffer(content)
+			if c.gzipRequestBodies {
+				if buffer, err = gzipBytes(content); err != nil {
+					return nil, err
+				}
+				gzipped = true
+			}
 		}
-		request, err = c.NewRequest(requestParams.Method, requestParams.URL.String(), buffer, requestParams.Headers)
+		request, err = c.NewRequestWithContext(ctx, requestParams.Method, requestParams.URL.String(), buffer, requestParams.Headers)
 		if err != nil {
 			return nil, err
 		}
+		if gzipped {
+			request.Header.Set("Content-Encoding", "gzip")
+		}
 
 	} else {
-		request, err = c.NewRequest(requestParams.Method, requestParams.URL.String(), nil, requestParams.Headers)
+		request, err = c.NewRequestWithContext(ctx, requestParams.Method, requestParams.URL.String(), nil, requestParams.Headers)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	response, err := c.Do(request)
+	var response *http.Response
+	if c.responseCache != nil {
+		response, err = c.responseCache.do(c, request)
+	} else {
+		response, err = c.Do(request)
+	}
 	if err != nil {
 		return nil, err
 	}
-	return util.ParseHTTPStatusCodeInResponse(response)
+	response, err = util.ParseHTTPStatusCodeInResponse(response)
+	if httpErr, ok := err.(*util.HTTPError); ok {
+		httpErr.Service = request.Operation.Service
+		httpErr.Operation = request.Operation.Name
+		httpErr.Attempts = request.NumAttempts
+	}
+	return response, err
 }
 
-func (c *BaseClient) makeFormRequest(requestParams gdepservices.RequestParams) (*Request, error) {
-	body := &bytes.Buffer{}
-	writer := multipart.NewWriter(body)
+// newReplayableRequest creates a request whose body is read from body as the request is sent, body is opened
+// again for each retry
+func (c *BaseClient) newReplayableRequest(ctx context.Context, requestParams gdepservices.RequestParams, body ReplayableBody) (*Request, error) {
+	reader, err := body.Open()
+	if err != nil {
+		return nil, err
+	}
+	request, err := c.NewRequestWithContext(ctx, requestParams.Method, requestParams.URL.String(), reader, requestParams.Headers)
+	if err != nil {
+		reader.Close()
+		return nil, err
+	}
+	request.GetBody = body.Open
+	// the length is unknown until the body has been sent
+	request.ContentLength = -1
+	return request, nil
+}
+
+func (c *BaseClient) makeFormRequest(ctx context.Context, requestParams gdepservices.RequestParams) (*Request, error) {
 	forms, ok := requestParams.Body.(gdepservices.FormData)
 	if !ok {
 		return nil, errors.New("bad request of form data")
 	}
+	// seekable streams, such as files, can be re-read for retries so need not be copied into memory
+	if seeker, ok := forms.Stream.(io.Seeker); ok && c.streamRequestBodies {
+		body, err := newMultipartBody(forms, seeker)
+		if err != nil {
+			return nil, err
+		}
+		request, err := c.newReplayableRequest(ctx, requestParams, body)
+		if err != nil {
+			return nil, err
+		}
+		request.Header.Set("Content-Type", body.contentType())
+		return request, nil
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
 
 	part, err := writer.CreateFormFile(forms.Key, forms.Filename)
 	if err != nil {
@@ -430,7 +664,7 @@ func (c *BaseClient) makeFormRequest(requestParams gdepservices.RequestParams) (
 
 	writer.Close()
 
-	request, err := c.NewRequest(requestParams.Method, requestParams.URL.String(), body, requestParams.Headers)
+	request, err := c.NewRequestWithContext(ctx, requestParams.Method, requestParams.URL.String(), body, requestParams.Headers)
 	if err != nil {
 		return nil, err
 	}
@@ -439,23 +673,148 @@ func (c *BaseClient) makeFormRequest(requestParams gdepservices.RequestParams) (
 	return request, err
 }
 
+// WithContext returns a client whose Get, Post, Put, Delete and Patch requests are bound to ctx, the
+// returned client shares all state (tokens, handlers, connections) with c. URLs built by the returned
+// client use the tenant and region set on ctx using WithTenant and WithRegion, if any.
+func (c *BaseClient) WithContext(ctx context.Context) gdepservices.IClient {
+	return &contextClient{BaseClient: c, ctx: ctx}
+}
+
+// contextClient binds the requests made through a BaseClient to a single context.Context
+type contextClient struct {
+	*BaseClient
+	ctx context.Context
+}
+
+// tenantAndRegion returns the tenant and region set on the client's context, or the client's defaults
+func (c *contextClient) tenantAndRegion() (string, string) {
+	tenant, region := c.BaseClient.tenantAndRegion()
+	if t, ok := c.ctx.Value(tenantKey{}).(string); ok {
+		tenant = t
+	}
+	if r, ok := c.ctx.Value(regionKey{}).(string); ok {
+		region = r
+	}
+	return tenant, region
+}
+
+// BuildURL creates full Splunk Cloud URL using the tenant and region of the client's context
+func (c *contextClient) BuildURL(queryValues url.Values, serviceCluster string, urlPathParts ...string) (url.URL, error) {
+	tenant, region := c.tenantAndRegion()
+	return c.BuildURLWithTenant(tenant, c.tenantScoped, region, queryValues, serviceCluster, urlPathParts...)
+}
+
+// BuildURLFromPathParams creates full Splunk Cloud URL from path template and path params using the tenant and
+// region of the client's context
+func (c *contextClient) BuildURLFromPathParams(queryValues url.Values, serviceCluster string, templ string, pathParams interface{}) (url.URL, error) {
+	tenant, region := c.tenantAndRegion()
+	return c.buildURLFromPathParams(tenant, region, queryValues, serviceCluster, templ, pathParams)
+}
+
+type tenantKey struct{}
+
+type regionKey struct{}
+
+// WithTenant returns a copy of ctx such that service calls made using ctx, e.g. using the WithContext variants
+// of service methods, are made to tenant rather than the client's default tenant:
+//
+//	jobs, err := client.SearchService.ListJobsWithContext(services.WithTenant(ctx, "acme"), nil)
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+type streamedResponseKey struct{}
+
+// WithStreamedResponse returns a copy of ctx such that the responses of service calls made using ctx are read
+// as they are received, e.g. by an iterator over a large export. Such requests are not limited by Config.Timeout,
+// which would also limit reading the response body, but only by ctx and the connection and response header
+// timeouts of Config.Transport, and their responses are never cached by a ResponseCache.
+func WithStreamedResponse(ctx context.Context) context.Context {
+	return context.WithValue(ctx, streamedResponseKey{}, true)
+}
+
+// isStreamedResponse returns whether the responses of requests made with ctx are streamed
+func isStreamedResponse(ctx context.Context) bool {
+	streamed, _ := ctx.Value(streamedResponseKey{}).(bool)
+	return streamed
+}
+
+// WithRegion returns a copy of ctx such that service calls made using ctx to tenant scoped hosts are made to
+// region rather than the client's default region
+func WithRegion(ctx context.Context, region string) context.Context {
+	return context.WithValue(ctx, regionKey{}, region)
+}
+
+// Get implements HTTP Get call bound to the client's context
+func (c *contextClient) Get(requestParams gdepservices.RequestParams) (*http.Response, error) {
+	requestParams.Method = http.MethodGet
+	return c.DoRequestWithContext(c.ctx, requestParams)
+}
+
+// Post implements HTTP POST call bound to the client's context
+func (c *contextClient) Post(requestParams gdepservices.RequestParams) (*http.Response, error) {
+	requestParams.Method = http.MethodPost
+	return c.DoRequestWithContext(c.ctx, requestParams)
+}
+
+// Put implements HTTP PUT call bound to the client's context
+func (c *contextClient) Put(requestParams gdepservices.RequestParams) (*http.Response, error) {
+	requestParams.Method = http.MethodPut
+	return c.DoRequestWithContext(c.ctx, requestParams)
+}
+
+// Delete implements HTTP DELETE call bound to the client's context
+func (c *contextClient) Delete(requestParams gdepservices.RequestParams) (*http.Response, error) {
+	requestParams.Method = http.MethodDelete
+	return c.DoRequestWithContext(c.ctx, requestParams)
+}
+
+// Patch implements HTTP Patch call bound to the client's context
+func (c *contextClient) Patch(requestParams gdepservices.RequestParams) (*http.Response, error) {
+	requestParams.Method = http.MethodPatch
+	return c.DoRequestWithContext(c.ctx, requestParams)
+}
+
 // UpdateTokenContext the access token in the Authorization: Bearer header and retains related context information
 func (c *BaseClient) UpdateTokenContext(ctx *idp.Context) {
-	c.tokenContext = ctx
+	c.tokenMux.Lock()
+	defer c.tokenMux.Unlock()
+	c.tokenContext.Store(ctx)
 }
 
 // GetDefaultTenant returns the tenant used to form most request URIs
 func (c *BaseClient) GetDefaultTenant() string {
+	c.settingsMux.RLock()
+	defer c.settingsMux.RUnlock()
 	return c.defaultTenant
 }
 
-// SetDefaultTenant updates the tenant used to form most request URIs
+// SetDefaultTenant updates the tenant used to form most request URIs, this is safe to call while requests are being made
 func (c *BaseClient) SetDefaultTenant(tenant string) {
+	c.settingsMux.Lock()
+	defer c.settingsMux.Unlock()
 	c.defaultTenant = tenant
 }
 
-// SetOverrideHost updates the host to force all requests to be made to `<scheme>://<overrideHost>/...` ignoring Config.Host and serviceCluster values
+// GetRegion returns the region used to form tenant scoped hosts for system requests
+func (c *BaseClient) GetRegion() string {
+	c.settingsMux.RLock()
+	defer c.settingsMux.RUnlock()
+	return c.region
+}
+
+// SetRegion updates the region used to form tenant scoped hosts for system requests, this is safe to call while requests are being made
+func (c *BaseClient) SetRegion(region string) {
+	c.settingsMux.Lock()
+	defer c.settingsMux.Unlock()
+	c.region = region
+}
+
+// SetOverrideHost updates the host to force all requests to be made to `<scheme>://<overrideHost>/...` ignoring Config.Host and serviceCluster values,
+// this is safe to call while requests are being made
 func (c *BaseClient) SetOverrideHost(host string) {
+	c.settingsMux.Lock()
+	defer c.settingsMux.Unlock()
 	c.overrideHost = host
 }
 
@@ -463,7 +822,7 @@ func (c *BaseClient) SetOverrideHost(host string) {
 func (c *BaseClient) GetURL(serviceCluster string) *url.URL {
 	appendToHost := ""
 	if c.tenantScoped == true {
-		appendToHost = c.defaultTenant
+		appendToHost = c.GetDefaultTenant()
 	}
 	host := c.BuildHost(serviceCluster, appendToHost)
 	return &url.URL{
@@ -522,10 +881,33 @@ func NewClient(config *Config) (*BaseClient, error) {
 			defaultStrategyHandler := DefaultRetryResponseHandler{DefaultRetryConfig{}}
 			handlers = append([]ResponseHandler{ResponseHandler(defaultStrategyHandler)}, config.ResponseHandlers...)
 		} else {
-			configStrategyHandler := ConfigurableRetryResponseHandler{ConfigurableRetryConfig{config.RetryConfig.ConfigurableRetryConfig.RetryNum, config.RetryConfig.ConfigurableRetryConfig.Interval, config.RetryConfig.ConfigurableRetryConfig.ShouldRetryFn}}
+			configStrategyHandler := ConfigurableRetryResponseHandler{*config.RetryConfig.ConfigurableRetryConfig}
 			handlers = append([]ResponseHandler{ResponseHandler(configStrategyHandler)}, config.ResponseHandlers...)
 		}
 	}
+	requestHandlers := config.RequestHandlers
+	if limiter := newRateLimiter(config.RateLimits); limiter != nil {
+		requestHandlers = append([]RequestHandler{limiter}, requestHandlers...)
+		handlers = append([]ResponseHandler{limiter}, handlers...)
+	}
+	if config.CircuitBreaker != nil {
+		requestHandlers = append([]RequestHandler{config.CircuitBreaker}, requestHandlers...)
+		handlers = append([]ResponseHandler{config.CircuitBreaker}, handlers...)
+	}
+	roundTripper := config.RoundTripper
+	if config.Transport != nil {
+		if roundTripper != nil {
+			return nil, errors.New("config.Transport and config.RoundTripper cannot both be set")
+		}
+		transport, err := util.NewTransport(config.Transport)
+		if err != nil {
+			return nil, err
+		}
+		roundTripper = transport
+		if tr, ok := config.TokenRetriever.(interface{ SetTransport(http.RoundTripper) }); ok {
+			tr.SetTransport(transport)
+		}
+	}
 	// Start by retrieving the access token
 	ctx, err := config.TokenRetriever.GetTokenContext()
 	if err != nil {
@@ -534,22 +916,38 @@ func NewClient(config *Config) (*BaseClient, error) {
 
 	// Finally, initialize the Client
 	c := &BaseClient{
-		rootDomain:        rootDomain,
-		overrideHost:      overrideHost,
-		scheme:            scheme,
-		defaultTenant:     config.Tenant,
-		httpClient:        &http.Client{Timeout: timeout},
-		tokenRetriever:    config.TokenRetriever,
-		tokenContext:      ctx,
-		responseHandlers:  handlers,
-		tokenExpireWindow: tokenExpireWindow,
-		clientVersion:     clientVersion,
-		tenantScoped:      config.TenantScoped,
-		region:            config.Region,
-	}
-
-	if config.RoundTripper != nil {
-		c.httpClient = &http.Client{Timeout: timeout, Transport: config.RoundTripper}
+		rootDomain:          rootDomain,
+		overrideHost:        overrideHost,
+		scheme:              scheme,
+		defaultTenant:       config.Tenant,
+		httpClient:          &http.Client{Timeout: timeout},
+		streamHTTPClient:    &http.Client{},
+		tokenRetriever:      config.TokenRetriever,
+		requestHandlers:     requestHandlers,
+		responseHandlers:    handlers,
+		tokenExpireWindow:   tokenExpireWindow,
+		clientVersion:       clientVersion,
+		tenantScoped:        config.TenantScoped,
+		region:              config.Region,
+		tracer:              config.Tracer,
+		streamRequestBodies: config.StreamRequestBodies,
+		gzipRequestBodies:   config.GzipRequestBodies,
+		responseCache:       config.ResponseCache,
+		dryRun:              config.DryRun,
+	}
+	c.tokenContext.Store(ctx)
+	if c.tracer == nil {
+		c.tracer = noopTracer{}
+	}
+	if config.RenewTokensInBackground {
+		c.renewStop = make(chan struct{})
+		c.renewDone = make(chan struct{})
+		go c.renewTokens()
+	}
+
+	if roundTripper != nil {
+		c.httpClient = &http.Client{Timeout: timeout, Transport: roundTripper}
+		c.streamHTTPClient = &http.Client{Transport: roundTripper}
 	}
 
 	return c, nil
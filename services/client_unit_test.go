@@ -17,12 +17,21 @@
 package services
 
 import (
+	"bytes"
+	"context"
+	"encoding/pem"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/splunk/go-dependencies/services"
 	"github.com/splunk/splunk-cloud-sdk-go/idp"
+	"github.com/splunk/splunk-cloud-sdk-go/util"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -243,7 +252,7 @@ func TestNewTokenClient(t *testing.T) {
 		Timeout: timeout,
 	})
 	require.NoError(t, err)
-	assert.Equal(t, token, client.tokenContext.AccessToken)
+	assert.Equal(t, token, client.tokenContext.Load().AccessToken)
 
 	testURL := client.GetURL("")
 	assert.Equal(t, clusterAPIHostname, testURL.Hostname())
@@ -378,7 +387,7 @@ func TestNewTokenRetrieverClient(t *testing.T) {
 	var tokenRetriever = &tRet{}
 	var client, err = NewClient(&Config{TokenRetriever: tokenRetriever})
 	require.NoError(t, err)
-	assert.Equal(t, client.tokenContext.AccessToken, xyzToken, "access token should have been initialized to X.Y.Z")
+	assert.Equal(t, client.tokenContext.Load().AccessToken, xyzToken, "access token should have been initialized to X.Y.Z")
 }
 
 func TestNewClientTokenAndTokenRetriever(t *testing.T) {
@@ -388,3 +397,148 @@ func TestNewClientTokenAndTokenRetriever(t *testing.T) {
 	// This should fail, users should specify Token or TokenRetriever, not both
 	assert.NotNil(t, err)
 }
+
+type ctxKey string
+
+// ctxRT records the context of each request it receives
+type ctxRT struct {
+	ctxs []context.Context
+}
+
+func (rt *ctxRT) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.ctxs = append(rt.ctxs, req.Context())
+	b := ioutil.NopCloser(bytes.NewReader([]byte("")))
+	return &http.Response{Status: "200 OK", StatusCode: 200, Body: b}, nil
+}
+
+func TestWithContextBindsRequests(t *testing.T) {
+	rt := &ctxRT{}
+	client, err := NewClient(&Config{
+		Token:        "testtoken",
+		Tenant:       "mytenant",
+		RoundTripper: rt,
+	})
+	require.NoError(t, err)
+	ctx := context.WithValue(context.Background(), ctxKey("caller"), "unit-test")
+	u, err := client.BuildURLFromPathParams(nil, "api", `/myservice/v1/widgets`, nil)
+	require.NoError(t, err)
+	_, err = client.WithContext(ctx).Get(services.RequestParams{URL: u})
+	require.NoError(t, err)
+	_, err = client.WithContext(ctx).Post(services.RequestParams{URL: u, Body: map[string]string{"a": "b"}})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(rt.ctxs))
+	for _, rctx := range rt.ctxs {
+		assert.Equal(t, "unit-test", rctx.Value(ctxKey("caller")))
+	}
+	// Requests made without a context should not carry the value
+	_, err = client.Get(services.RequestParams{URL: u})
+	require.NoError(t, err)
+	assert.Nil(t, rt.ctxs[2].Value(ctxKey("caller")))
+}
+
+func TestWithTenantAndRegionOverrides(t *testing.T) {
+	client, err := NewClient(&Config{
+		Token:        "testtoken",
+		Tenant:       "mytenant",
+		TenantScoped: true,
+		Host:         "myenv.scs.splunk.com",
+		Region:       "region10",
+	})
+	require.NoError(t, err)
+	ctx := WithRegion(WithTenant(context.Background(), "acme"), "region20")
+	u, err := client.WithContext(ctx).BuildURLFromPathParams(nil, "api", `/myservice/v1/widgets`, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "https://acme.api.myenv.scs.splunk.com/acme/myservice/v1/widgets", u.String())
+	u, err = client.WithContext(ctx).BuildURLFromPathParams(nil, "api", `/system/myservice/v1/widgets`, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "https://region-region20.api.myenv.scs.splunk.com/system/myservice/v1/widgets", u.String())
+	u, err = client.WithContext(ctx).(*contextClient).BuildURL(nil, "api", "myservice", "v1", "widgets")
+	require.NoError(t, err)
+	assert.Equal(t, "https://acme.api.myenv.scs.splunk.com/acme/myservice/v1/widgets", u.String())
+	// The client defaults should be unchanged
+	u, err = client.BuildURLFromPathParams(nil, "api", `/myservice/v1/widgets`, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "https://mytenant.api.myenv.scs.splunk.com/mytenant/myservice/v1/widgets", u.String())
+	// A context without overrides should use the client defaults
+	u, err = client.WithContext(context.Background()).BuildURLFromPathParams(nil, "api", `/system/myservice/v1/widgets`, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "https://region-region10.api.myenv.scs.splunk.com/system/myservice/v1/widgets", u.String())
+}
+
+func TestSettersConcurrentWithRequests(t *testing.T) {
+	client, err := NewClient(&Config{
+		Token:        "testtoken",
+		Tenant:       "mytenant",
+		RoundTripper: &ctxRT{},
+	})
+	require.NoError(t, err)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			client.SetDefaultTenant(fmt.Sprintf("tenant%d", i))
+			client.SetOverrideHost(fmt.Sprintf("host%d:8080", i))
+			client.SetRegion(fmt.Sprintf("region%d", i))
+		}(i)
+		go func() {
+			defer wg.Done()
+			u, err := client.BuildURLFromPathParams(nil, "api", `/myservice/v1/widgets`, nil)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, client.GetURL("api").Host)
+			assert.NotEmpty(t, u.Host)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, "region", client.GetRegion()[:6])
+}
+
+func TestDoRequestWithContextTokenRenewal(t *testing.T) {
+	client, err := NewClient(&Config{
+		TokenRetriever: &tRet{},
+		RoundTripper:   &ctxRT{},
+	})
+	require.NoError(t, err)
+	// an expired token is renewed before the request is made
+	client.UpdateTokenContext(&idp.Context{AccessToken: xyzToken, StartTime: time.Now().Unix() - 7200, ExpiresIn: 3600})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = client.DoRequestWithContext(ctx, services.RequestParams{Method: http.MethodGet})
+	require.Error(t, err)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestNewClientTransport(t *testing.T) {
+	var paths []string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"tlstoken","expires_in":3600,"token_type":"Bearer"}`))
+	}))
+	defer server.Close()
+	transport := &util.TransportConfig{
+		RootCAPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}),
+	}
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	_, err = NewClient(&Config{Token: "testtoken", Transport: transport, RoundTripper: &ctxRT{}})
+	assert.Error(t, err, "Transport and RoundTripper are mutually exclusive")
+
+	// The transport trusting the server's certificate is used by both the token retriever and the client
+	tr := idp.NewClientCredentialsRetriever("clientid", "secret", "scope", "", server.URL, idp.HostURLConfig{})
+	client, err := NewClient(&Config{
+		TokenRetriever: tr,
+		Tenant:         "mytenant",
+		OverrideHost:   serverURL.Host,
+		Transport:      transport,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "tlstoken", client.tokenContext.Load().AccessToken)
+	u, err := client.BuildURLFromPathParams(nil, "api", `/myservice/v1/widgets`, nil)
+	require.NoError(t, err)
+	resp, err := client.Get(services.RequestParams{URL: u})
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, []string{"/token", "/mytenant/myservice/v1/widgets"}, paths)
+}
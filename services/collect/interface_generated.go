@@ -19,7 +19,10 @@
 package collect
 
 import (
+	"context"
 	"net/http"
+
+	sdkutil "github.com/splunk/splunk-cloud-sdk-go/util"
 )
 
 // ServicerGenerated represents the interface for implementing all endpoints for this service
@@ -101,4 +104,97 @@ type ServicerGenerated interface {
 			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
 	*/
 	PatchJobs(jobsPatch JobsPatch, query *PatchJobsQueryParams, resp ...*http.Response) (*PatchJobsResponse, error)
+	/*
+		CreateExecutionWithContext - Creates an execution for a scheduled job based on the job ID.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			jobId: The job ID.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	CreateExecutionWithContext(ctx context.Context, jobId string, resp ...*http.Response) (*SingleExecutionResponse, error)
+	/*
+		CreateJobWithContext - Creates a job.
+		This API returns &#x60;403&#x60; if the number of collect workers is over a certain limit.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			job: The API request schema for the job.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	CreateJobWithContext(ctx context.Context, job Job, resp ...*http.Response) (*SingleJobResponse, error)
+	/*
+		DeleteJobWithContext - Removes a job based on the job ID.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			jobId: The job ID.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	DeleteJobWithContext(ctx context.Context, jobId string, resp ...*http.Response) error
+	/*
+		DeleteJobsWithContext - Removes all jobs on a tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	DeleteJobsWithContext(ctx context.Context, resp ...*http.Response) (*DeleteJobsResponse, error)
+	/*
+		GetExecutionWithContext - Returns the execution details based on the execution ID and job ID.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			jobId: The job ID.
+			executionUid: The execution UID.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetExecutionWithContext(ctx context.Context, jobId string, executionUid string, resp ...*http.Response) (*SingleExecutionResponse, error)
+	/*
+		GetJobWithContext - Returns a job based on the job ID.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			jobId: The job ID.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetJobWithContext(ctx context.Context, jobId string, resp ...*http.Response) (*SingleJobResponse, error)
+	/*
+		ListJobsWithContext - Returns a list of all jobs that belong to a tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			query: a struct pointer of valid query parameters for the endpoint, nil to send no query parameters
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListJobsWithContext(ctx context.Context, query *ListJobsQueryParams, resp ...*http.Response) (*ListJobsResponse, error)
+	/*
+		PatchExecutionWithContext - Modifies an execution based on the job ID.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			jobId: The job ID.
+			executionUid: The execution UID.
+			executionPatch: The API request schema for patching an execution.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	PatchExecutionWithContext(ctx context.Context, jobId string, executionUid string, executionPatch ExecutionPatch, resp ...*http.Response) error
+	/*
+		PatchJobWithContext - Modifies a job based on the job ID.
+		This API returns &#x60;403&#x60; if the number of collect workers is over a certain limit.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			jobId: The job ID.
+			jobPatch: The API request schema for patching a job.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	PatchJobWithContext(ctx context.Context, jobId string, jobPatch JobPatch, resp ...*http.Response) (*SingleJobResponse, error)
+	/*
+		PatchJobsWithContext - Finds all jobs that match the query and modifies the with the changes specified in the request.
+		This is a non-atomic operation and the results are returned as a list with each job patch result as its element. This API returns &#x60;200 OK&#x60; regardless of how many jobs were successfully patched. You must read the response body to find out if all jobs are patched. When the API is called, the &#x60;jobIDs&#x60; or &#x60;connectorID&#x60; must be specified. Do not specify more than one of them at the same time. This API returns &#x60;403&#x60; if the number of collect workers is over a certain limit.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			jobsPatch: The API request schema for patching jobs.
+			query: a struct pointer of valid query parameters for the endpoint, nil to send no query parameters
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	PatchJobsWithContext(ctx context.Context, jobsPatch JobsPatch, query *PatchJobsQueryParams, resp ...*http.Response) (*PatchJobsResponse, error)
+	// ListJobsPages returns a Pager over the pages of ListJobs, which returns a single page
+	ListJobsPages(query *ListJobsQueryParams) *sdkutil.Pager[BaseJob]
+	// ListJobsAll returns the items of all pages of ListJobs
+	ListJobsAll(query *ListJobsQueryParams) ([]BaseJob, error)
+	// ListJobsAllWithContext - ListJobsAll bound to ctx
+	ListJobsAllWithContext(ctx context.Context, query *ListJobsQueryParams) ([]BaseJob, error)
 }
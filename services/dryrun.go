@@ -0,0 +1,170 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	gdepservices "github.com/splunk/go-dependencies/services"
+	"github.com/splunk/splunk-cloud-sdk-go/util"
+)
+
+// ErrDryRun is wrapped by the errors returned in place of sending requests in dry run mode
+var ErrDryRun = errors.New("dry run, request not sent")
+
+// DryRunRequest is a request which a client in dry run mode resolved but did not send
+type DryRunRequest struct {
+	Method string `json:"method"`
+	// URL includes the tenant scoped host, if any, and the query
+	URL string `json:"url"`
+	// Header has the Authorization header, if any, redacted. The body of a request with a
+	// "Content-Encoding: gzip" header would have been compressed
+	Header http.Header `json:"header"`
+	// Body is the body as it would have been sent before any compression, JSON bodies are marshaled with
+	// MarshalJSONByMethod if implemented. Body is nil for multipart file uploads, which are not read
+	Body []byte `json:"-"`
+	// Operation identifies the service endpoint, see MatchOperation
+	Operation Operation `json:"operation"`
+}
+
+// MarshalJSON encodes r with JSON bodies inline and other bodies as strings
+func (r *DryRunRequest) MarshalJSON() ([]byte, error) {
+	type request DryRunRequest
+	var body interface{}
+	if json.Valid(r.Body) {
+		body = json.RawMessage(r.Body)
+	} else if r.Body != nil {
+		body = string(r.Body)
+	}
+	return json.Marshal(struct {
+		*request
+		Body interface{} `json:"body,omitempty"`
+	}{(*request)(r), body})
+}
+
+// String formats r like an HTTP/1.1 request
+func (r *DryRunRequest) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s\n", r.Method, r.URL)
+	_ = r.Header.Write(&b)
+	if len(r.Body) > 0 {
+		b.WriteString("\n")
+		b.Write(r.Body)
+	}
+	return b.String()
+}
+
+// DryRunError is returned by service calls made in dry run mode in place of sending the request
+type DryRunError struct {
+	Request *DryRunRequest
+}
+
+func (e *DryRunError) Error() string {
+	return fmt.Sprintf("%v: %s %s", ErrDryRun, e.Request.Method, e.Request.URL)
+}
+
+// Unwrap returns ErrDryRun
+func (e *DryRunError) Unwrap() error {
+	return ErrDryRun
+}
+
+// DryRun returns the request resolved by a service call made in dry run mode from the error it returned, and
+// false if err is not from a dry run:
+//
+//	_, err := client.SearchService.CreateJobWithContext(services.WithDryRun(ctx), job)
+//	if request, ok := services.DryRun(err); ok {
+//		fmt.Println(request)
+//	}
+func DryRun(err error) (*DryRunRequest, bool) {
+	var dryRunErr *DryRunError
+	if errors.As(err, &dryRunErr) {
+		return dryRunErr.Request, true
+	}
+	return nil, false
+}
+
+type dryRunKey struct{}
+
+// WithDryRun returns a copy of ctx such that service calls made using ctx, e.g. using the WithContext variants of
+// service methods, are resolved but not sent, as if Config.DryRun were set
+func WithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunKey{}, true)
+}
+
+// isDryRun returns whether the requests made by c with ctx should not be sent
+func (c *BaseClient) isDryRun(ctx context.Context) bool {
+	dryRun, _ := ctx.Value(dryRunKey{}).(bool)
+	return c.dryRun || dryRun
+}
+
+// dryRun returns a DryRunError for the request which would be made for requestParams
+func (c *BaseClient) dryRunRequest(ctx context.Context, requestParams gdepservices.RequestParams) error {
+	body, err := dryRunBody(requestParams)
+	if err != nil {
+		return err
+	}
+	request, err := c.NewRequestWithContext(ctx, requestParams.Method, requestParams.URL.String(), nil, requestParams.Headers)
+	if err != nil {
+		return err
+	}
+	if auth := request.Header.Get("Authorization"); auth != "" {
+		request.Header.Set("Authorization", AuthorizationType+" "+util.Redacted)
+	}
+	switch requestParams.Body.(type) {
+	case nil, []byte, gdepservices.FormData, ReplayableBody:
+	default:
+		if c.gzipRequestBodies {
+			request.Header.Set("Content-Encoding", "gzip")
+		}
+	}
+	return &DryRunError{Request: &DryRunRequest{
+		Method:    requestParams.Method,
+		URL:       requestParams.URL.String(),
+		Header:    request.Header,
+		Body:      body,
+		Operation: request.Operation,
+	}}
+}
+
+// dryRunBody returns the body which would be sent for requestParams
+func dryRunBody(requestParams gdepservices.RequestParams) ([]byte, error) {
+	switch body := requestParams.Body.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		return body, nil
+	case gdepservices.FormData:
+		return nil, nil
+	case util.MethodMarshaler:
+		return body.MarshalJSONByMethod(requestParams.Method)
+	case ReplayableBody:
+		reader, err := body.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		return ioutil.ReadAll(reader)
+	default:
+		return json.Marshal(body)
+	}
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	neturl "net/url"
+	"testing"
+
+	"github.com/splunk/go-dependencies/services"
+	"github.com/splunk/splunk-cloud-sdk-go/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// patchBody marshals only its set fields for PATCH requests
+type patchBody struct {
+	Name string `json:"name"`
+	Size int    `json:"size"`
+}
+
+func (b patchBody) MarshalJSONByMethod(method string) ([]byte, error) {
+	if method == http.MethodPatch {
+		return json.Marshal(map[string]string{"name": b.Name})
+	}
+	return json.Marshal(b)
+}
+
+func TestDryRunConfig(t *testing.T) {
+	rt := &cacheRT{}
+	client, err := NewClient(&Config{Token: "secrettoken", Tenant: "mytenant", RoundTripper: rt, DryRun: true, TenantScoped: true, Region: "iad10"})
+	require.NoError(t, err)
+	u, err := client.BuildURLFromPathParams(neturl.Values{"limit": {"10"}}, "api", "/catalog/v2/datasets/{{.ID}}", map[string]string{"ID": "ds1"})
+	require.NoError(t, err)
+	response, err := client.Patch(services.RequestParams{URL: u, Body: patchBody{Name: "ds1", Size: 3}})
+	assert.Nil(t, response)
+	assert.True(t, errors.Is(err, ErrDryRun))
+	assert.Equal(t, 0, rt.count(), "no request should be sent")
+
+	request, ok := DryRun(err)
+	require.True(t, ok)
+	assert.Equal(t, http.MethodPatch, request.Method)
+	assert.Equal(t, "https://mytenant.api.scp.splunk.com/mytenant/catalog/v2/datasets/ds1?limit=10", request.URL)
+	assert.Equal(t, "Bearer "+util.Redacted, request.Header.Get("Authorization"))
+	assert.Equal(t, "application/json", request.Header.Get("Content-Type"))
+	assert.JSONEq(t, `{"name":"ds1"}`, string(request.Body))
+	assert.NotContains(t, request.String(), "secrettoken")
+
+	encoded, err := json.Marshal(request)
+	require.NoError(t, err)
+	assert.Contains(t, string(encoded), `"body":{"name":"ds1"}`)
+}
+
+func TestDryRunContext(t *testing.T) {
+	rt := &cacheRT{bodies: map[string]string{"/mytenant/catalog/v2/datasets": `[]`}}
+	client, err := NewClient(&Config{Token: "testtoken", Tenant: "mytenant", RoundTripper: rt, GzipRequestBodies: true})
+	require.NoError(t, err)
+	u, err := client.BuildURLFromPathParams(nil, "api", "/catalog/v2/datasets", nil)
+	require.NoError(t, err)
+
+	_, err = client.WithContext(WithDryRun(context.Background())).Post(services.RequestParams{URL: u, Body: patchBody{Name: "ds1", Size: 3}})
+	request, ok := DryRun(err)
+	require.True(t, ok)
+	assert.JSONEq(t, `{"name":"ds1","size":3}`, string(request.Body))
+	assert.Equal(t, "gzip", request.Header.Get("Content-Encoding"))
+	assert.Equal(t, 0, rt.count())
+
+	// calls without the context are sent
+	_, err = client.Get(services.RequestParams{URL: u})
+	require.NoError(t, err)
+	_, ok = DryRun(err)
+	assert.False(t, ok)
+	assert.Equal(t, 1, rt.count())
+}
@@ -19,7 +19,10 @@
 package forwarders
 
 import (
+	"context"
 	"net/http"
+
+	sdkutil "github.com/splunk/splunk-cloud-sdk-go/util"
 )
 
 // ServicerGenerated represents the interface for implementing all endpoints for this service
@@ -50,4 +53,40 @@ type ServicerGenerated interface {
 			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
 	*/
 	ListCertificates(resp ...*http.Response) ([]CertificateInfo, error)
+	/*
+		AddCertificateWithContext - Adds a certificate to a vacant slot on a tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			certificate
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	AddCertificateWithContext(ctx context.Context, certificate Certificate, resp ...*http.Response) (*CertificateInfo, error)
+	/*
+		DeleteCertificateWithContext - Removes a certificate on a particular slot on a tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			slot
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	DeleteCertificateWithContext(ctx context.Context, slot string, resp ...*http.Response) error
+	/*
+		DeleteCertificatesWithContext - Removes all certificates on a tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	DeleteCertificatesWithContext(ctx context.Context, resp ...*http.Response) error
+	/*
+		ListCertificatesWithContext - Returns a list of all certificates for a tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListCertificatesWithContext(ctx context.Context, resp ...*http.Response) ([]CertificateInfo, error)
+	// ListCertificatesPages returns a Pager over the pages of ListCertificates, which returns a single page
+	ListCertificatesPages() *sdkutil.Pager[CertificateInfo]
+	// ListCertificatesAll returns the items of all pages of ListCertificates
+	ListCertificatesAll() ([]CertificateInfo, error)
+	// ListCertificatesAllWithContext - ListCertificatesAll bound to ctx
+	ListCertificatesAllWithContext(ctx context.Context) ([]CertificateInfo, error)
 }
@@ -18,6 +18,7 @@ package services
 
 import (
 	"errors"
+	"net"
 	"net/http"
 	"syscall"
 
@@ -43,6 +44,15 @@ type ConfigurableRetryConfig struct {
 	Interval int
 	// ShouldRetryFn defines a custom function to determine whether or not to retry the request
 	ShouldRetryFn ShouldRetry
+	// Backoff (optional) determines the delay between retries, overriding Interval - see ExponentialBackoff
+	// for exponential backoff with full jitter, a maximum delay and a maximum total elapsed time
+	Backoff Backoff
+	// MaxRetryAfter is the (optional) maximum delay honored from the Retry-After header of a response, responses
+	// asking to wait longer are not retried. Default to 1 minute
+	MaxRetryAfter time.Duration
+	// RetryServerErrors if true also retries 502, 503 and 504 responses and network timeouts for requests
+	// with idempotent methods (GET, HEAD, OPTIONS, PUT, DELETE), ignored if ShouldRetryFn is specified
+	RetryServerErrors bool
 }
 
 //DefaultRetryConfig that will use a default RetryNumber and a default Interval between retries
@@ -53,6 +63,7 @@ type DefaultRetryConfig struct {
 const (
 	defaultMaxRetryCount  = 6
 	defaultIntervalMillis = 500
+	defaultMaxRetryAfter  = 1 * time.Minute
 )
 
 func defaultShouldRetryFn(request *Request, reqErr error, response *http.Response, maxRetries uint) bool {
@@ -71,12 +82,53 @@ func defaultShouldRetryFn(request *Request, reqErr error, response *http.Respons
 	return false
 }
 
+func serverErrorShouldRetryFn(request *Request, reqErr error, response *http.Response, maxRetries uint) bool {
+	if defaultShouldRetryFn(request, reqErr, response, maxRetries) {
+		return true
+	}
+	// Beyond the defaults only retry requests that are safe to repeat
+	if request == nil || request.NumAttempts > maxRetries || !isIdempotent(request) {
+		return false
+	}
+	if response != nil {
+		switch response.StatusCode {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+	// Retry network timeouts, unless the timeout was caused by the request's own context
+	var netErr net.Error
+	return reqErr != nil && errors.As(reqErr, &netErr) && netErr.Timeout() && request.Context().Err() == nil
+}
+
 //RetryStrategyConfig to be specified while creating a NewClient
 type RetryStrategyConfig struct {
 	DefaultRetryConfig      *DefaultRetryConfig
 	ConfigurableRetryConfig *ConfigurableRetryConfig
 }
 
+// RequestHandler defines the interface for implementing custom request handling logic,
+// HandleRequest is called before each attempt of a request is sent (including retries) and may
+// modify the request, e.g. to add headers, or return an error to prevent the request being sent
+type RequestHandler interface {
+	HandleRequest(client *BaseClient, request *Request) error
+}
+
+// RequestHandlerFunc is an adapter allowing an ordinary function to be used as a RequestHandler
+type RequestHandlerFunc func(client *BaseClient, request *Request) error
+
+// HandleRequest calls f(client, request)
+func (f RequestHandlerFunc) HandleRequest(client *BaseClient, request *Request) error {
+	return f(client, request)
+}
+
+// RequestVetoHandler is implemented by RequestHandlers which need to know when a request they allowed is vetoed
+// by a later RequestHandler, e.g. to release what they reserved for the request
+type RequestVetoHandler interface {
+	HandleRequestVeto(client *BaseClient, request *Request, err error)
+}
+
 // ResponseHandler defines the interface for implementing custom response
 // handling logic, request errors are not handled - implement ResponseOrErrorHandler for
 // handling of request errors
@@ -102,20 +154,22 @@ func (rh AuthnResponseHandler) HandleResponse(client *BaseClient, request *Reque
 	if response.StatusCode != 401 || rh.TokenRetriever == nil || request.GetNumErrorsByResponseCode(401) > DefaultMaxAuthnAttempts {
 		return response, nil
 	}
-	ctx, err := rh.TokenRetriever.GetTokenContext()
+	// Retrieve a new token, unless another request has already done so, and update the client such that
+	// future requests will use the new access token and retain context information
+	ctx, err := client.refreshToken(request.Context(), rh.TokenRetriever, accessToken(request))
 	if err != nil {
 		return response, err
 	}
 	// Replace the access token in the request's Authorization: Bearer header
 	request.UpdateToken(ctx.AccessToken)
 	// Re-initialize body (otherwise body is empty)
-	body, err := request.GetBody()
-	if err != nil {
-		return nil, err
+	if request.GetBody != nil {
+		body, err := request.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		request.Body = body
 	}
-	request.Body = body
-	// Update the client such that future requests will use the new access token and retain context information
-	client.UpdateTokenContext(ctx)
 	// Retry the request with the updated token
 	return client.Do(request)
 }
@@ -127,13 +181,17 @@ type DefaultRetryResponseHandler struct {
 
 // HandleResponse will retry a request once a 429 is encountered using a Default exponential BackOff Retry Strategy
 func (defRh DefaultRetryResponseHandler) HandleResponse(client *BaseClient, request *Request, response *http.Response) (*http.Response, error) {
-	return handleRequestResponse(client, request, nil, response, defaultMaxRetryCount, defaultIntervalMillis, defaultShouldRetryFn)
+	return handleRequestResponse(client, request, nil, response, defaultMaxRetryCount, defaultBackoff(), defaultMaxRetryAfter, defaultShouldRetryFn)
 }
 
 // HandleRequestError will retry a request if a connection reset is encountered using a
 // Default exponential BackOff Retry Strategy
 func (defRh DefaultRetryResponseHandler) HandleRequestError(client *BaseClient, request *Request, err error) (*http.Response, error) {
-	return handleRequestResponse(client, request, err, nil, defaultMaxRetryCount, defaultIntervalMillis, defaultShouldRetryFn)
+	return handleRequestResponse(client, request, err, nil, defaultMaxRetryCount, defaultBackoff(), defaultMaxRetryAfter, defaultShouldRetryFn)
+}
+
+func defaultBackoff() Backoff {
+	return ExponentialBackoff{Interval: defaultIntervalMillis * time.Millisecond}
 }
 
 // ConfigurableRetryResponseHandler handles logic for retrying requests with user configurable
@@ -145,25 +203,43 @@ type ConfigurableRetryResponseHandler struct {
 // HandleResponse will retry a request if a 429 is encountered using a configurable exponential
 // BackOff Retry Strategy
 func (configRh ConfigurableRetryResponseHandler) HandleResponse(client *BaseClient, request *Request, response *http.Response) (*http.Response, error) {
-	retryFn := configRh.ConfigurableRetryConfig.ShouldRetryFn
-	if retryFn == nil {
-		retryFn = defaultShouldRetryFn
-	}
-	return handleRequestResponse(client, request, nil, response, configRh.ConfigurableRetryConfig.RetryNum, configRh.ConfigurableRetryConfig.Interval, retryFn)
+	cfg := configRh.ConfigurableRetryConfig
+	return handleRequestResponse(client, request, nil, response, cfg.RetryNum, cfg.backoff(), cfg.maxRetryAfter(), cfg.shouldRetryFn())
 }
 
 // HandleRequestError will retry a request once a connection reset is encountered using
 // a Configurable exponential BackOff Retry Strategy
 func (configRh ConfigurableRetryResponseHandler) HandleRequestError(client *BaseClient, request *Request, err error) (*http.Response, error) {
-	retryFn := configRh.ConfigurableRetryConfig.ShouldRetryFn
-	if retryFn == nil {
-		retryFn = defaultShouldRetryFn
+	cfg := configRh.ConfigurableRetryConfig
+	return handleRequestResponse(client, request, err, nil, cfg.RetryNum, cfg.backoff(), cfg.maxRetryAfter(), cfg.shouldRetryFn())
+}
+
+func (cfg ConfigurableRetryConfig) backoff() Backoff {
+	if cfg.Backoff != nil {
+		return cfg.Backoff
+	}
+	return ExponentialBackoff{Interval: time.Duration(cfg.Interval) * time.Millisecond}
+}
+
+func (cfg ConfigurableRetryConfig) maxRetryAfter() time.Duration {
+	if cfg.MaxRetryAfter > 0 {
+		return cfg.MaxRetryAfter
+	}
+	return defaultMaxRetryAfter
+}
+
+func (cfg ConfigurableRetryConfig) shouldRetryFn() ShouldRetry {
+	if cfg.ShouldRetryFn != nil {
+		return cfg.ShouldRetryFn
+	}
+	if cfg.RetryServerErrors {
+		return serverErrorShouldRetryFn
 	}
-	return handleRequestResponse(client, request, err, nil, configRh.ConfigurableRetryConfig.RetryNum, configRh.ConfigurableRetryConfig.Interval, retryFn)
+	return defaultShouldRetryFn
 }
 
 //handleRequestResponse - helper function to handle the retry to a 429 response
-func handleRequestResponse(client *BaseClient, request *Request, reqErr error, response *http.Response, maxRetries uint, interval int, shouldRetry ShouldRetry) (*http.Response, error) {
+func handleRequestResponse(client *BaseClient, request *Request, reqErr error, response *http.Response, maxRetries uint, backoff Backoff, maxRetryAfter time.Duration, shouldRetry ShouldRetry) (*http.Response, error) {
 	if request == nil {
 		return response, reqErr // can't retry the request without it
 	}
@@ -171,9 +247,34 @@ func handleRequestResponse(client *BaseClient, request *Request, reqErr error, r
 	if !shouldRetry(request, reqErr, response, maxRetries) {
 		return response, reqErr
 	}
-	// implement exponential back off by increasing the waiting time between retries after each retry failure.
-	backoffMillis := time.Duration((1<<request.NumAttempts)*interval) * time.Millisecond
-	time.Sleep(backoffMillis)
+	delay, ok := backoff.Next(request.NumAttempts, request.elapsed())
+	if !ok {
+		return response, reqErr
+	}
+	// honor the server's Retry-After if it asks for a longer wait, unless it asks for too long
+	if after, found := retryAfter(response); found {
+		if after > maxRetryAfter {
+			return response, reqErr
+		}
+		if after > delay {
+			delay = after
+		}
+	}
+	timer := time.NewTimer(delay)
+	select {
+	case <-timer.C:
+	case <-request.Context().Done():
+		// the caller is no longer waiting on this request, give up rather than retrying
+		timer.Stop()
+		if response != nil {
+			response.Body.Close()
+		}
+		return nil, request.Context().Err()
+	}
+	// the response is being replaced by that of the retry
+	if response != nil {
+		response.Body.Close()
+	}
 
 	// reinitialize body, otherwise it will be empty
 	if request.Body != nil {
@@ -18,11 +18,14 @@ package services
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"syscall"
 	"testing"
+	"time"
 
 	"github.com/splunk/go-dependencies/services"
 	"github.com/splunk/splunk-cloud-sdk-go/idp"
@@ -252,3 +255,231 @@ func TestClientCustomRetryFunc(t *testing.T) {
 	// RoundTripper should be called a total of three times due to custom retry func
 	assert.Equal(t, 3, rt.N, "RoundTripper should have been called 3 times")
 }
+
+func TestClientRetryContextDone(t *testing.T) {
+	rt := &test429RT{}
+	client, err := NewClient(&Config{
+		Token:         "testtoken",
+		RetryRequests: true,
+		RetryConfig: RetryStrategyConfig{
+			ConfigurableRetryConfig: &ConfigurableRetryConfig{
+				RetryNum: 6,
+				Interval: 60000, // 1 minute so that the context deadline is reached while backing off
+			},
+		},
+		RoundTripper: rt,
+	})
+	require.Nil(t, err, "Error calling NewClient(): %s", err)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	resp, err := client.DoRequestWithContext(ctx, services.RequestParams{Method: http.MethodGet})
+	assert.Nil(t, resp)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.True(t, time.Since(start) < 10*time.Second, "backoff should have been interrupted by the context deadline")
+	assert.Equal(t, 1, rt.N, "RoundTripper should have been called once before the context deadline")
+}
+
+func TestClientRequestHandlers(t *testing.T) {
+	rt := &test429RT{}
+	var ops []Operation
+	var attempts []uint
+	var keys []string
+	audit := RequestHandlerFunc(func(client *BaseClient, request *Request) error {
+		ops = append(ops, request.Operation)
+		attempts = append(attempts, request.NumAttempts)
+		return nil
+	})
+	idempotencyKey := RequestHandlerFunc(func(client *BaseClient, request *Request) error {
+		// retries should reuse the same key
+		if request.Header.Get("Idempotency-Key") == "" {
+			request.Header.Set("Idempotency-Key", fmt.Sprintf("key-%d", len(ops)))
+		}
+		keys = append(keys, request.Header.Get("Idempotency-Key"))
+		return nil
+	})
+	client, err := NewClient(&Config{
+		Token:           "testtoken",
+		Tenant:          "mytenant",
+		RequestHandlers: []RequestHandler{audit, idempotencyKey},
+		RetryRequests:   true,
+		RetryConfig: RetryStrategyConfig{
+			ConfigurableRetryConfig: &ConfigurableRetryConfig{
+				RetryNum: 4,
+				Interval: 10, // 10 ms so tests execute quickly
+			},
+		},
+		RoundTripper: rt,
+	})
+	require.Nil(t, err, "Error calling NewClient(): %s", err)
+	u, err := client.BuildURLFromPathParams(nil, "api", `/widgets/v1/widgets`, nil)
+	require.NoError(t, err)
+	resp, err := client.Post(services.RequestParams{URL: u, Body: map[string]string{"name": "w"}})
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, []uint{1, 2, 3, 4}, attempts, "request handlers should be called for every attempt")
+	for _, op := range ops {
+		assert.Equal(t, "CreateWidget", op.Name)
+		assert.Equal(t, "widgets", op.Service)
+	}
+	assert.Equal(t, []string{"key-1", "key-1", "key-1", "key-1"}, keys)
+}
+
+func TestClientRequestHandlerVeto(t *testing.T) {
+	rt := &test429RT{}
+	handler := &respHandler{}
+	veto := RequestHandlerFunc(func(client *BaseClient, request *Request) error {
+		return fmt.Errorf("requests to %s are not allowed", request.Operation.Service)
+	})
+	client, err := NewClient(&Config{
+		Token:            "testtoken",
+		Tenant:           "mytenant",
+		RequestHandlers:  []RequestHandler{veto},
+		ResponseHandlers: []ResponseHandler{handler},
+		RoundTripper:     rt,
+	})
+	require.Nil(t, err, "Error calling NewClient(): %s", err)
+	u, err := client.BuildURLFromPathParams(nil, "api", `/widgets/v1/widgets`, nil)
+	require.NoError(t, err)
+	resp, err := client.Get(services.RequestParams{URL: u})
+	assert.Nil(t, resp)
+	require.Error(t, err)
+	assert.Equal(t, "requests to widgets are not allowed", err.Error())
+	assert.Equal(t, 0, rt.N, "vetoed requests should not be sent")
+	assert.Equal(t, 0, handler.NResp, "response handlers should not be called for vetoed requests")
+}
+
+// statusRT responds with each of Statuses in turn then 200 OK, setting Retry-After if specified
+type statusRT struct {
+	N          int
+	Statuses   []int
+	RetryAfter string
+}
+
+func (rt *statusRT) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.N++
+	b := ioutil.NopCloser(bytes.NewReader([]byte("")))
+	if rt.N <= len(rt.Statuses) {
+		code := rt.Statuses[rt.N-1]
+		header := http.Header{}
+		if rt.RetryAfter != "" {
+			header.Set("Retry-After", rt.RetryAfter)
+		}
+		return &http.Response{Status: fmt.Sprintf("%d %s", code, http.StatusText(code)), StatusCode: code, Header: header, Body: b}, nil
+	}
+	return &http.Response{Status: "200 OK", StatusCode: 200, Body: b}, nil
+}
+
+func TestClientRetryAfter(t *testing.T) {
+	rt := &statusRT{Statuses: []int{429}, RetryAfter: "1"}
+	client, err := NewClient(&Config{
+		Token:         "testtoken",
+		RetryRequests: true,
+		RetryConfig: RetryStrategyConfig{
+			ConfigurableRetryConfig: &ConfigurableRetryConfig{
+				RetryNum: 2,
+				Interval: 10, // 10 ms so tests execute quickly
+			},
+		},
+		RoundTripper: rt,
+	})
+	require.Nil(t, err, "Error calling NewClient(): %s", err)
+	start := time.Now()
+	resp, err := client.Get(services.RequestParams{})
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, 2, rt.N, "RoundTripper should have been called 2 times")
+	assert.True(t, time.Since(start) >= time.Second, "the retry should have waited for the Retry-After delay")
+}
+
+func TestClientRetryAfterExceedsMax(t *testing.T) {
+	rt := &statusRT{Statuses: []int{429}, RetryAfter: "120"}
+	client, err := NewClient(&Config{
+		Token:         "testtoken",
+		RetryRequests: true,
+		RetryConfig: RetryStrategyConfig{
+			ConfigurableRetryConfig: &ConfigurableRetryConfig{
+				RetryNum: 2,
+				Interval: 10, // 10 ms so tests execute quickly
+			},
+		},
+		RoundTripper: rt,
+	})
+	require.Nil(t, err, "Error calling NewClient(): %s", err)
+	resp, err := client.Get(services.RequestParams{})
+	assert.Nil(t, resp)
+	require.Error(t, err)
+	httpErr, ok := err.(*util.HTTPError)
+	require.True(t, ok)
+	assert.Equal(t, 429, httpErr.HTTPStatusCode)
+	assert.Equal(t, 1, rt.N, "requests asked to wait longer than MaxRetryAfter should not be retried")
+}
+
+func TestClientRetryServerErrors(t *testing.T) {
+	newClient := func(rt http.RoundTripper) *BaseClient {
+		client, err := NewClient(&Config{
+			Token:         "testtoken",
+			RetryRequests: true,
+			RetryConfig: RetryStrategyConfig{
+				ConfigurableRetryConfig: &ConfigurableRetryConfig{
+					RetryNum:          4,
+					Backoff:           ExponentialBackoff{Interval: time.Millisecond, MaxDelay: 5 * time.Millisecond, FullJitter: true},
+					RetryServerErrors: true,
+				},
+			},
+			RoundTripper: rt,
+		})
+		require.Nil(t, err, "Error calling NewClient(): %s", err)
+		return client
+	}
+	rt := &statusRT{Statuses: []int{503, 502, 504}}
+	resp, err := newClient(rt).Get(services.RequestParams{})
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, 4, rt.N, "idempotent requests should be retried for server errors")
+
+	rt = &statusRT{Statuses: []int{503}}
+	_, err = newClient(rt).Post(services.RequestParams{})
+	require.Error(t, err)
+	httpErr, ok := err.(*util.HTTPError)
+	require.True(t, ok)
+	assert.Equal(t, 503, httpErr.HTTPStatusCode)
+	assert.Equal(t, 1, rt.N, "non-idempotent requests should not be retried for server errors")
+
+	rt = &statusRT{Statuses: []int{500}}
+	_, err = newClient(rt).Get(services.RequestParams{})
+	require.Error(t, err)
+	assert.Equal(t, 1, rt.N, "500 responses should not be retried")
+}
+
+// notFoundRT responds 404 Not Found with a JSON body and request id
+type notFoundRT struct{}
+
+func (rt *notFoundRT) RoundTrip(req *http.Request) (*http.Response, error) {
+	b := ioutil.NopCloser(bytes.NewReader([]byte(`{"code":"1019","message":"widget not found"}`)))
+	header := http.Header{util.RequestIDHeader: []string{"req-1234"}}
+	return &http.Response{Status: "404 Not Found", StatusCode: 404, Header: header, Body: b}, nil
+}
+
+func TestClientHTTPErrorContext(t *testing.T) {
+	client, err := NewClient(&Config{
+		Token:        "testtoken",
+		Tenant:       "mytenant",
+		RoundTripper: &notFoundRT{},
+	})
+	require.NoError(t, err)
+	u, err := client.BuildURLFromPathParams(nil, "api", `/widgets/v1/widgets/1234`, nil)
+	require.NoError(t, err)
+	_, err = client.Get(services.RequestParams{URL: u})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, util.ErrNotFound))
+	assert.False(t, errors.Is(err, util.ErrServerError))
+	var httpErr *util.HTTPError
+	require.True(t, errors.As(err, &httpErr))
+	assert.Equal(t, "widgets", httpErr.Service)
+	assert.Equal(t, "GetWidget", httpErr.Operation)
+	assert.Equal(t, "req-1234", httpErr.RequestID)
+	assert.Equal(t, uint(1), httpErr.Attempts)
+	assert.Equal(t, "widget not found", httpErr.Message)
+	assert.Equal(t, `{"code":"1019","message":"widget not found"}`, string(httpErr.Body))
+}
@@ -19,7 +19,10 @@
 package identity
 
 import (
+	"context"
 	"net/http"
+
+	sdkutil "github.com/splunk/splunk-cloud-sdk-go/util"
 )
 
 // ServicerGenerated represents the interface for implementing all endpoints for this service
@@ -482,4 +485,594 @@ type ServicerGenerated interface {
 			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
 	*/
 	ValidateToken(query *ValidateTokenQueryParams, resp ...*http.Response) (*ValidateInfo, error)
+	/*
+		AddGroupMemberWithContext - identity service endpoint
+		Adds a member to a given group.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			group: The group name.
+			addGroupMemberBody: The member to add to a group.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	AddGroupMemberWithContext(ctx context.Context, group string, addGroupMemberBody AddGroupMemberBody, resp ...*http.Response) (*GroupMember, error)
+	/*
+		AddGroupRoleWithContext - identity service endpoint
+		Adds a role to a given group.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			group: The group name.
+			addGroupRoleBody: The role to add to a group.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	AddGroupRoleWithContext(ctx context.Context, group string, addGroupRoleBody AddGroupRoleBody, resp ...*http.Response) (*GroupRole, error)
+	/*
+		AddMemberWithContext - identity service endpoint
+		Adds a member to a given tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			addMemberBody: The member to associate with a tenant.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	AddMemberWithContext(ctx context.Context, addMemberBody AddMemberBody, resp ...*http.Response) (*Member, error)
+	/*
+		AddPrincipalPublicKeyWithContext - identity service endpoint
+		Add service principal public key
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			principal: The principal name.
+			ecJwk: Service principal public key
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	AddPrincipalPublicKeyWithContext(ctx context.Context, principal string, ecJwk EcJwk, resp ...*http.Response) (*PrincipalPublicKey, error)
+	/*
+		AddRolePermissionWithContext - identity service endpoint
+		Adds permissions to a role in a given tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			role: The role name.
+			addRolePermissionBody: The permission to add to a role.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	AddRolePermissionWithContext(ctx context.Context, role string, addRolePermissionBody AddRolePermissionBody, resp ...*http.Response) (*RolePermission, error)
+	/*
+		CreateGroupWithContext - identity service endpoint
+		Creates a new group in a given tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			createGroupBody: The group definition.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	CreateGroupWithContext(ctx context.Context, createGroupBody CreateGroupBody, resp ...*http.Response) (*Group, error)
+	/*
+		CreateIdentityProviderWithContext - identity service endpoint
+		Create an Identity Provider.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			identityProviderConfigBody: The Identity Provider to create.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	CreateIdentityProviderWithContext(ctx context.Context, identityProviderConfigBody IdentityProviderConfigBody, resp ...*http.Response) (*IdentityProviderBody, error)
+	/*
+		CreatePrincipalWithContext - identity service endpoint
+		Create a new principal
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			createPrincipalBody: The new principal to add to the system.
+			query: a struct pointer of valid query parameters for the endpoint, nil to send no query parameters
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	CreatePrincipalWithContext(ctx context.Context, createPrincipalBody CreatePrincipalBody, query *CreatePrincipalQueryParams, resp ...*http.Response) (*Principal, error)
+	/*
+		CreateRoleWithContext - identity service endpoint
+		Creates a new authorization role in a given tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			createRoleBody: Role definition
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	CreateRoleWithContext(ctx context.Context, createRoleBody CreateRoleBody, resp ...*http.Response) (*Role, error)
+	/*
+		CreateSamlClientWithContext - identity service endpoint
+		Create a SAML client.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			createSamlClientBody
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	CreateSamlClientWithContext(ctx context.Context, createSamlClientBody CreateSamlClientBody, resp ...*http.Response) (*SamlClient, error)
+	/*
+		DeleteGroupWithContext - identity service endpoint
+		Deletes a group in a given tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			group: The group name.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	DeleteGroupWithContext(ctx context.Context, group string, resp ...*http.Response) error
+	/*
+		DeleteIdentityProviderWithContext - identity service endpoint
+		Deletes the Identity Provider.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			idp: The Identity Provider name.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	DeleteIdentityProviderWithContext(ctx context.Context, idp string, resp ...*http.Response) error
+	/*
+		DeletePrincipalPublicKeyWithContext - identity service endpoint
+		Deletes principal public key
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			principal: The principal name.
+			keyId: Identifier of a public key.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	DeletePrincipalPublicKeyWithContext(ctx context.Context, principal string, keyId string, resp ...*http.Response) error
+	/*
+		DeleteRoleWithContext - identity service endpoint
+		Deletes a defined role for a given tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			role: The role name.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	DeleteRoleWithContext(ctx context.Context, role string, resp ...*http.Response) error
+	/*
+		DeleteSamlClientWithContext - identity service endpoint
+		Deletes the SAML client.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			samlClient: The saml client name.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	DeleteSamlClientWithContext(ctx context.Context, samlClient string, resp ...*http.Response) error
+	/*
+		GetEntitlementsWithContext - identity service endpoint
+		Returns the entitlements for the given tenant and client id
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			entitlementClientId: ID of the client for commerce entitlements
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetEntitlementsWithContext(ctx context.Context, entitlementClientId string, resp ...*http.Response) (*EntitlementList, error)
+	/*
+		GetGroupWithContext - identity service endpoint
+		Returns information about a given group within a tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			group: The group name.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetGroupWithContext(ctx context.Context, group string, resp ...*http.Response) (*Group, error)
+	/*
+		GetGroupMemberWithContext - identity service endpoint
+		Returns information about a given member within a given group.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			group: The group name.
+			member: The member name.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetGroupMemberWithContext(ctx context.Context, group string, member string, resp ...*http.Response) (*GroupMember, error)
+	/*
+		GetGroupRoleWithContext - identity service endpoint
+		Returns information about a given role within a given group.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			group: The group name.
+			role: The role name.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetGroupRoleWithContext(ctx context.Context, group string, role string, resp ...*http.Response) (*GroupRole, error)
+	/*
+		GetIdentityProviderWithContext - identity service endpoint
+		Returns the Identity Provider for the given tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			idp: The Identity Provider name.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetIdentityProviderWithContext(ctx context.Context, idp string, resp ...*http.Response) (*IdentityProviderBody, error)
+	/*
+		GetMemberWithContext - identity service endpoint
+		Returns a member of a given tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			member: The member name.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetMemberWithContext(ctx context.Context, member string, resp ...*http.Response) (*Member, error)
+	/*
+		GetPrincipalWithContext - identity service endpoint
+		Returns the details of a principal, including its tenant membership and any relevant profile information.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			principal: The principal name.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetPrincipalWithContext(ctx context.Context, principal string, resp ...*http.Response) (*Principal, error)
+	/*
+		GetPrincipalPublicKeyWithContext - identity service endpoint
+		Returns principal public key
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			principal: The principal name.
+			keyId: Identifier of a public key.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetPrincipalPublicKeyWithContext(ctx context.Context, principal string, keyId string, resp ...*http.Response) (*PrincipalPublicKey, error)
+	/*
+		GetPrincipalPublicKeysWithContext - identity service endpoint
+		Returns principal public keys
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			principal: The principal name.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetPrincipalPublicKeysWithContext(ctx context.Context, principal string, resp ...*http.Response) (*PrincipalPublicKeys, error)
+	/*
+		GetRoleWithContext - identity service endpoint
+		Returns a role for a given tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			role: The role name.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetRoleWithContext(ctx context.Context, role string, resp ...*http.Response) (*Role, error)
+	/*
+		GetRolePermissionWithContext - identity service endpoint
+		Gets a permission for the specified role.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			role: The role name.
+			permission: The permission string.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetRolePermissionWithContext(ctx context.Context, role string, permission string, resp ...*http.Response) (*RolePermission, error)
+	/*
+		GetSamlClientWithContext - identity service endpoint
+		Returns the SAML client.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			samlClient: The saml client name.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetSamlClientWithContext(ctx context.Context, samlClient string, resp ...*http.Response) (*SamlClient, error)
+	/*
+		ListGroupMembersWithContext - identity service endpoint
+		Returns a list of the members within a given group.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			group: The group name.
+			query: a struct pointer of valid query parameters for the endpoint, nil to send no query parameters
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListGroupMembersWithContext(ctx context.Context, group string, query *ListGroupMembersQueryParams, resp ...*http.Response) (*GroupMemberList, error)
+	/*
+		ListGroupRolesWithContext - identity service endpoint
+		Returns a list of the roles that are attached to a group within a given tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			group: The group name.
+			query: a struct pointer of valid query parameters for the endpoint, nil to send no query parameters
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListGroupRolesWithContext(ctx context.Context, group string, query *ListGroupRolesQueryParams, resp ...*http.Response) (*GroupRoleList, error)
+	/*
+		ListGroupsWithContext - identity service endpoint
+		List the groups that exist in a given tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			query: a struct pointer of valid query parameters for the endpoint, nil to send no query parameters
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListGroupsWithContext(ctx context.Context, query *ListGroupsQueryParams, resp ...*http.Response) (*GroupList, error)
+	/*
+		ListIdentityProviderWithContext - identity service endpoint
+		Returns the list of Identity Providers for the given tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListIdentityProviderWithContext(ctx context.Context, resp ...*http.Response) ([]IdentityProviderBody, error)
+	/*
+		ListMemberGroupsWithContext - identity service endpoint
+		Returns a list of groups that a member belongs to within a tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			member: The member name.
+			query: a struct pointer of valid query parameters for the endpoint, nil to send no query parameters
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListMemberGroupsWithContext(ctx context.Context, member string, query *ListMemberGroupsQueryParams, resp ...*http.Response) (*GroupList, error)
+	/*
+		ListMemberPermissionsWithContext - identity service endpoint
+		Returns a set of permissions granted to the member within the tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			member: The member name.
+			query: a struct pointer of valid query parameters for the endpoint, nil to send no query parameters
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListMemberPermissionsWithContext(ctx context.Context, member string, query *ListMemberPermissionsQueryParams, resp ...*http.Response) (*PermissionList, error)
+	/*
+		ListMemberRolesWithContext - identity service endpoint
+		Returns a set of roles that a given member holds within the tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			member: The member name.
+			query: a struct pointer of valid query parameters for the endpoint, nil to send no query parameters
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListMemberRolesWithContext(ctx context.Context, member string, query *ListMemberRolesQueryParams, resp ...*http.Response) (*RoleList, error)
+	/*
+		ListMembersWithContext - identity service endpoint
+		Returns a list of members in a given tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			query: a struct pointer of valid query parameters for the endpoint, nil to send no query parameters
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListMembersWithContext(ctx context.Context, query *ListMembersQueryParams, resp ...*http.Response) (*MemberList, error)
+	/*
+		ListPrincipalsWithContext - identity service endpoint
+		Returns the list of principals that the Identity service knows about.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			query: a struct pointer of valid query parameters for the endpoint, nil to send no query parameters
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListPrincipalsWithContext(ctx context.Context, query *ListPrincipalsQueryParams, resp ...*http.Response) (*PrincipalList, error)
+	/*
+		ListRoleGroupsWithContext - identity service endpoint
+		Gets a list of groups for a role in a given tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			role: The role name.
+			query: a struct pointer of valid query parameters for the endpoint, nil to send no query parameters
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListRoleGroupsWithContext(ctx context.Context, role string, query *ListRoleGroupsQueryParams, resp ...*http.Response) (*GroupList, error)
+	/*
+		ListRolePermissionsWithContext - identity service endpoint
+		Gets the permissions for a role in a given tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			role: The role name.
+			query: a struct pointer of valid query parameters for the endpoint, nil to send no query parameters
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListRolePermissionsWithContext(ctx context.Context, role string, query *ListRolePermissionsQueryParams, resp ...*http.Response) (*RolePermissionList, error)
+	/*
+		ListRolesWithContext - identity service endpoint
+		Returns all roles for a given tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			query: a struct pointer of valid query parameters for the endpoint, nil to send no query parameters
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListRolesWithContext(ctx context.Context, query *ListRolesQueryParams, resp ...*http.Response) (*RoleList, error)
+	/*
+		ListSamlClientsWithContext - identity service endpoint
+		List SAML clients.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListSamlClientsWithContext(ctx context.Context, resp ...*http.Response) (*SamlClientsList, error)
+	/*
+		RemoveGroupMemberWithContext - identity service endpoint
+		Removes the member from a given group.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			group: The group name.
+			member: The member name.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	RemoveGroupMemberWithContext(ctx context.Context, group string, member string, resp ...*http.Response) error
+	/*
+		RemoveGroupRoleWithContext - identity service endpoint
+		Removes a role from a given group.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			group: The group name.
+			role: The role name.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	RemoveGroupRoleWithContext(ctx context.Context, group string, role string, resp ...*http.Response) error
+	/*
+		RemoveMemberWithContext - identity service endpoint
+		Removes a member from a given tenant
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			member: The member name.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	RemoveMemberWithContext(ctx context.Context, member string, resp ...*http.Response) error
+	/*
+		RemoveRolePermissionWithContext - identity service endpoint
+		Removes a permission from the role.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			role: The role name.
+			permission: The permission string.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	RemoveRolePermissionWithContext(ctx context.Context, role string, permission string, resp ...*http.Response) error
+	/*
+		ResetPasswordWithContext - identity service endpoint
+		Sends an email which allows a principal to reset a forgotten password.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			resetPasswordBody: The principal information to recover password.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ResetPasswordWithContext(ctx context.Context, resetPasswordBody ResetPasswordBody, resp ...*http.Response) error
+	/*
+		RevokePrincipalAuthTokensWithContext - identity service endpoint
+		Revoke all existing access tokens issued to a principal. Principals can reset their password by visiting https://login.splunk.com/en_us/page/lost_password
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			principal: The principal name.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	RevokePrincipalAuthTokensWithContext(ctx context.Context, principal string, resp ...*http.Response) error
+	/*
+		UpdateEntitlementsWithContext - identity service endpoint
+		Update the entitlements for the given tenant and client id
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			entitlementClientId: ID of the client for commerce entitlements
+			setEntitlement: The desired entitlements to be set
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	UpdateEntitlementsWithContext(ctx context.Context, entitlementClientId string, setEntitlement []SetEntitlement, resp ...*http.Response) (*EntitlementList, error)
+	/*
+		UpdateGroupWithContext - identity service endpoint
+		Updates a group&#39;s display name or description.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			group: The group name.
+			updateGroupBody: The updated group information
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	UpdateGroupWithContext(ctx context.Context, group string, updateGroupBody UpdateGroupBody, resp ...*http.Response) (*Group, error)
+	/*
+		UpdateIdentityProviderWithContext - identity service endpoint
+		Update the configuration for an Identity Provider.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			idp: The Identity Provider name.
+			identityProviderConfigBody: The properties to update the Identity Provider with.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	UpdateIdentityProviderWithContext(ctx context.Context, idp string, identityProviderConfigBody IdentityProviderConfigBody, resp ...*http.Response) (*IdentityProviderBody, error)
+	/*
+		UpdatePasswordWithContext - identity service endpoint
+		Update principal password
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			principal: The principal name.
+			updatePasswordBody: The new password to set for the principal.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	UpdatePasswordWithContext(ctx context.Context, principal string, updatePasswordBody UpdatePasswordBody, resp ...*http.Response) error
+	/*
+		UpdatePrincipalPublicKeyWithContext - identity service endpoint
+		Update principal public key
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			principal: The principal name.
+			keyId: Identifier of a public key.
+			principalPublicKeyStatusBody: Status of the public key
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	UpdatePrincipalPublicKeyWithContext(ctx context.Context, principal string, keyId string, principalPublicKeyStatusBody PrincipalPublicKeyStatusBody, resp ...*http.Response) (*PrincipalPublicKey, error)
+	/*
+		UpdateRoleWithContext - identity service endpoint
+		Update a role&#39;s display name or description for a given tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			role: The role name.
+			updateRoleBody: The updated role information
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	UpdateRoleWithContext(ctx context.Context, role string, updateRoleBody UpdateRoleBody, resp ...*http.Response) (*Role, error)
+	/*
+		UpdateSamlClientWithContext - identity service endpoint
+		Update the SAML client.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			samlClient: The saml client name.
+			updateSamlClientBody
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	UpdateSamlClientWithContext(ctx context.Context, samlClient string, updateSamlClientBody UpdateSamlClientBody, resp ...*http.Response) (*SamlClient, error)
+	/*
+		ValidateTokenWithContext - identity service endpoint
+		Validates the access token obtained from the authorization header and returns the principal name and tenant memberships.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			query: a struct pointer of valid query parameters for the endpoint, nil to send no query parameters
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ValidateTokenWithContext(ctx context.Context, query *ValidateTokenQueryParams, resp ...*http.Response) (*ValidateInfo, error)
+	// ListGroupMembersPages returns a Pager over the pages of ListGroupMembers, following the nextLink of each page
+	ListGroupMembersPages(group string, query *ListGroupMembersQueryParams) *sdkutil.Pager[GroupMember]
+	// ListGroupMembersAll returns the items of all pages of ListGroupMembers
+	ListGroupMembersAll(group string, query *ListGroupMembersQueryParams) ([]GroupMember, error)
+	// ListGroupMembersAllWithContext - ListGroupMembersAll bound to ctx
+	ListGroupMembersAllWithContext(ctx context.Context, group string, query *ListGroupMembersQueryParams) ([]GroupMember, error)
+	// ListGroupRolesPages returns a Pager over the pages of ListGroupRoles, following the nextLink of each page
+	ListGroupRolesPages(group string, query *ListGroupRolesQueryParams) *sdkutil.Pager[GroupRole]
+	// ListGroupRolesAll returns the items of all pages of ListGroupRoles
+	ListGroupRolesAll(group string, query *ListGroupRolesQueryParams) ([]GroupRole, error)
+	// ListGroupRolesAllWithContext - ListGroupRolesAll bound to ctx
+	ListGroupRolesAllWithContext(ctx context.Context, group string, query *ListGroupRolesQueryParams) ([]GroupRole, error)
+	// ListGroupsPages returns a Pager over the pages of ListGroups, following the nextLink of each page
+	ListGroupsPages(query *ListGroupsQueryParams) *sdkutil.Pager[Group]
+	// ListGroupsAll returns the items of all pages of ListGroups
+	ListGroupsAll(query *ListGroupsQueryParams) ([]Group, error)
+	// ListGroupsAllWithContext - ListGroupsAll bound to ctx
+	ListGroupsAllWithContext(ctx context.Context, query *ListGroupsQueryParams) ([]Group, error)
+	// ListIdentityProviderPages returns a Pager over the pages of ListIdentityProvider, which returns a single page
+	ListIdentityProviderPages() *sdkutil.Pager[IdentityProviderBody]
+	// ListIdentityProviderAll returns the items of all pages of ListIdentityProvider
+	ListIdentityProviderAll() ([]IdentityProviderBody, error)
+	// ListIdentityProviderAllWithContext - ListIdentityProviderAll bound to ctx
+	ListIdentityProviderAllWithContext(ctx context.Context) ([]IdentityProviderBody, error)
+	// ListMemberGroupsPages returns a Pager over the pages of ListMemberGroups, following the nextLink of each page
+	ListMemberGroupsPages(member string, query *ListMemberGroupsQueryParams) *sdkutil.Pager[Group]
+	// ListMemberGroupsAll returns the items of all pages of ListMemberGroups
+	ListMemberGroupsAll(member string, query *ListMemberGroupsQueryParams) ([]Group, error)
+	// ListMemberGroupsAllWithContext - ListMemberGroupsAll bound to ctx
+	ListMemberGroupsAllWithContext(ctx context.Context, member string, query *ListMemberGroupsQueryParams) ([]Group, error)
+	// ListMemberPermissionsPages returns a Pager over the pages of ListMemberPermissions, following the nextLink of each page
+	ListMemberPermissionsPages(member string, query *ListMemberPermissionsQueryParams) *sdkutil.Pager[string]
+	// ListMemberPermissionsAll returns the items of all pages of ListMemberPermissions
+	ListMemberPermissionsAll(member string, query *ListMemberPermissionsQueryParams) ([]string, error)
+	// ListMemberPermissionsAllWithContext - ListMemberPermissionsAll bound to ctx
+	ListMemberPermissionsAllWithContext(ctx context.Context, member string, query *ListMemberPermissionsQueryParams) ([]string, error)
+	// ListMemberRolesPages returns a Pager over the pages of ListMemberRoles, following the nextLink of each page
+	ListMemberRolesPages(member string, query *ListMemberRolesQueryParams) *sdkutil.Pager[Role]
+	// ListMemberRolesAll returns the items of all pages of ListMemberRoles
+	ListMemberRolesAll(member string, query *ListMemberRolesQueryParams) ([]Role, error)
+	// ListMemberRolesAllWithContext - ListMemberRolesAll bound to ctx
+	ListMemberRolesAllWithContext(ctx context.Context, member string, query *ListMemberRolesQueryParams) ([]Role, error)
+	// ListMembersPages returns a Pager over the pages of ListMembers, following the nextLink of each page
+	ListMembersPages(query *ListMembersQueryParams) *sdkutil.Pager[Member]
+	// ListMembersAll returns the items of all pages of ListMembers
+	ListMembersAll(query *ListMembersQueryParams) ([]Member, error)
+	// ListMembersAllWithContext - ListMembersAll bound to ctx
+	ListMembersAllWithContext(ctx context.Context, query *ListMembersQueryParams) ([]Member, error)
+	// ListPrincipalsPages returns a Pager over the pages of ListPrincipals, following the nextLink of each page
+	ListPrincipalsPages(query *ListPrincipalsQueryParams) *sdkutil.Pager[Principal]
+	// ListPrincipalsAll returns the items of all pages of ListPrincipals
+	ListPrincipalsAll(query *ListPrincipalsQueryParams) ([]Principal, error)
+	// ListPrincipalsAllWithContext - ListPrincipalsAll bound to ctx
+	ListPrincipalsAllWithContext(ctx context.Context, query *ListPrincipalsQueryParams) ([]Principal, error)
+	// ListRoleGroupsPages returns a Pager over the pages of ListRoleGroups, following the nextLink of each page
+	ListRoleGroupsPages(role string, query *ListRoleGroupsQueryParams) *sdkutil.Pager[Group]
+	// ListRoleGroupsAll returns the items of all pages of ListRoleGroups
+	ListRoleGroupsAll(role string, query *ListRoleGroupsQueryParams) ([]Group, error)
+	// ListRoleGroupsAllWithContext - ListRoleGroupsAll bound to ctx
+	ListRoleGroupsAllWithContext(ctx context.Context, role string, query *ListRoleGroupsQueryParams) ([]Group, error)
+	// ListRolePermissionsPages returns a Pager over the pages of ListRolePermissions, following the nextLink of each page
+	ListRolePermissionsPages(role string, query *ListRolePermissionsQueryParams) *sdkutil.Pager[RolePermission]
+	// ListRolePermissionsAll returns the items of all pages of ListRolePermissions
+	ListRolePermissionsAll(role string, query *ListRolePermissionsQueryParams) ([]RolePermission, error)
+	// ListRolePermissionsAllWithContext - ListRolePermissionsAll bound to ctx
+	ListRolePermissionsAllWithContext(ctx context.Context, role string, query *ListRolePermissionsQueryParams) ([]RolePermission, error)
+	// ListRolesPages returns a Pager over the pages of ListRoles, following the nextLink of each page
+	ListRolesPages(query *ListRolesQueryParams) *sdkutil.Pager[Role]
+	// ListRolesAll returns the items of all pages of ListRoles
+	ListRolesAll(query *ListRolesQueryParams) ([]Role, error)
+	// ListRolesAllWithContext - ListRolesAll bound to ctx
+	ListRolesAllWithContext(ctx context.Context, query *ListRolesQueryParams) ([]Role, error)
+	// ListSamlClientsPages returns a Pager over the pages of ListSamlClients, which returns a single page
+	ListSamlClientsPages() *sdkutil.Pager[string]
+	// ListSamlClientsAll returns the items of all pages of ListSamlClients
+	ListSamlClientsAll() ([]string, error)
+	// ListSamlClientsAllWithContext - ListSamlClientsAll bound to ctx
+	ListSamlClientsAllWithContext(ctx context.Context) ([]string, error)
 }
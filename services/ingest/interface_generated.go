@@ -19,7 +19,10 @@
 package ingest
 
 import (
+	"context"
 	"net/http"
+
+	sdkutil "github.com/splunk/splunk-cloud-sdk-go/util"
 )
 
 // ServicerGenerated represents the interface for implementing all endpoints for this service
@@ -87,4 +90,82 @@ type ServicerGenerated interface {
 	       resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
 	*/
 	UploadFiles(filename string, resp ...*http.Response) error
+	/*
+		DeleteAllCollectorTokensWithContext - Delete All dsphec tokens for a given tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	DeleteAllCollectorTokensWithContext(ctx context.Context, resp ...*http.Response) (*map[string]interface{}, error)
+	/*
+		DeleteCollectorTokenWithContext - Delete dsphec token by name.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			tokenName
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	DeleteCollectorTokenWithContext(ctx context.Context, tokenName string, resp ...*http.Response) (*map[string]interface{}, error)
+	/*
+		GetCollectorTokenWithContext - Get the metadata of a dsphec token by name.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			tokenName
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetCollectorTokenWithContext(ctx context.Context, tokenName string, resp ...*http.Response) (*HecTokenAccessResponse, error)
+	/*
+		ListCollectorTokensWithContext - List dsphec tokens for a tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			query: a struct pointer of valid query parameters for the endpoint, nil to send no query parameters
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListCollectorTokensWithContext(ctx context.Context, query *ListCollectorTokensQueryParams, resp ...*http.Response) ([]HecTokenAccessResponse, error)
+	/*
+		PostCollectorTokensWithContext - Creates dsphec tokens.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			hecTokenCreateRequest: The API request schema for the token.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	PostCollectorTokensWithContext(ctx context.Context, hecTokenCreateRequest HecTokenCreateRequest, resp ...*http.Response) (*HecTokenCreateResponse, error)
+	/*
+		PostEventsWithContext - Sends events.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			event
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	PostEventsWithContext(ctx context.Context, event []Event, resp ...*http.Response) (*HttpResponse, error)
+	/*
+		PostMetricsWithContext - Sends metric events.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			metricEvent
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	PostMetricsWithContext(ctx context.Context, metricEvent []MetricEvent, resp ...*http.Response) (*HttpResponse, error)
+	/*
+		PutCollectorTokenWithContext - Update the metadata of a dsphec token by name.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			tokenName
+			hecTokenUpdateRequest: The API request schema for the token.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	PutCollectorTokenWithContext(ctx context.Context, tokenName string, hecTokenUpdateRequest HecTokenUpdateRequest, resp ...*http.Response) (*HecTokenAccessResponse, error)
+	/*
+	   UploadFilesWithContext - Upload a CSV or text file that contains events. The file limit is 1MB or an error is returned.
+	   Parameters:
+	   	ctx: the context.Context bound to the request, used for cancellation and deadlines
+	       filename
+	       resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	UploadFilesWithContext(ctx context.Context, filename string, resp ...*http.Response) error
+	// ListCollectorTokensPages returns a Pager over the pages of ListCollectorTokens, advancing the offset by the number of items of each page
+	ListCollectorTokensPages(query *ListCollectorTokensQueryParams) *sdkutil.Pager[HecTokenAccessResponse]
+	// ListCollectorTokensAll returns the items of all pages of ListCollectorTokens
+	ListCollectorTokensAll(query *ListCollectorTokensQueryParams) ([]HecTokenAccessResponse, error)
+	// ListCollectorTokensAllWithContext - ListCollectorTokensAll bound to ctx
+	ListCollectorTokensAllWithContext(ctx context.Context, query *ListCollectorTokensQueryParams) ([]HecTokenAccessResponse, error)
 }
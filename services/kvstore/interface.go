@@ -18,8 +18,20 @@
 
 package kvstore
 
+import (
+	"context"
+
+	"github.com/splunk/splunk-cloud-sdk-go/util"
+)
+
 // Servicer represents the interface for implementing all endpoints for this service
 type Servicer interface {
+	//interfaces that cannot be auto-generated from codegen
+	// ListRecordsStream returns an iterator over the records in a collection which decodes records as they are read
+	ListRecordsStream(collection string, query *ListRecordsQueryParams) (*util.JSONIterator[map[string]interface{}], error)
+	// ListRecordsStreamWithContext is ListRecordsStream with the request and reading of records bound to ctx
+	ListRecordsStreamWithContext(ctx context.Context, collection string, query *ListRecordsQueryParams) (*util.JSONIterator[map[string]interface{}], error)
+
 	//interfaces that are auto-generated in interface_generated.go
 	ServicerGenerated
 }
@@ -19,7 +19,10 @@
 package kvstore
 
 import (
+	"context"
 	"net/http"
+
+	sdkutil "github.com/splunk/splunk-cloud-sdk-go/util"
 )
 
 // ServicerGenerated represents the interface for implementing all endpoints for this service
@@ -127,4 +130,132 @@ type ServicerGenerated interface {
 			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
 	*/
 	TruncateRecords(collection string, resp ...*http.Response) error
+	/*
+		CreateIndexWithContext - Creates an index on a collection.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			collection: The name of the collection.
+			indexDefinition
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	CreateIndexWithContext(ctx context.Context, collection string, indexDefinition IndexDefinition, resp ...*http.Response) (*IndexDescription, error)
+	/*
+		DeleteIndexWithContext - Removes an index from a collection.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			collection: The name of the collection.
+			index: The name of the index.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	DeleteIndexWithContext(ctx context.Context, collection string, index string, resp ...*http.Response) error
+	/*
+		DeleteRecordByKeyWithContext - Deletes a record with a given key.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			collection: The name of the collection.
+			key: The key of the record.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	DeleteRecordByKeyWithContext(ctx context.Context, collection string, key string, resp ...*http.Response) error
+	/*
+		DeleteRecordsWithContext - Removes records in a collection that match the query.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			collection: The name of the collection.
+			query: a struct pointer of valid query parameters for the endpoint, nil to send no query parameters
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	DeleteRecordsWithContext(ctx context.Context, collection string, query *DeleteRecordsQueryParams, resp ...*http.Response) error
+	/*
+		GetRecordByKeyWithContext - Returns a record with a given key.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			collection: The name of the collection.
+			key: The key of the record.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetRecordByKeyWithContext(ctx context.Context, collection string, key string, resp ...*http.Response) (*map[string]interface{}, error)
+	/*
+		InsertRecordWithContext - Inserts a record into a collection.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			collection: The name of the collection.
+			body: Record to add to the collection, formatted as a JSON object.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	InsertRecordWithContext(ctx context.Context, collection string, body map[string]interface{}, resp ...*http.Response) (*Record, error)
+	/*
+		InsertRecordsWithContext - Writes multiple records in a single request. If records have duplicate primary keys, only the last duplicate record will be written. If no errors occur, the response array will contain the keys of the written records, in no particular order.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			collection: The name of the collection.
+			requestBody: Array of records to insert.
+			query: a struct pointer of valid query parameters for the endpoint, nil to send no query parameters
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	InsertRecordsWithContext(ctx context.Context, collection string, requestBody []map[string]interface{}, query *InsertRecordsQueryParams, resp ...*http.Response) ([]string, error)
+	/*
+		ListIndexesWithContext - Returns a list of all indexes on a collection.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			collection: The name of the collection.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListIndexesWithContext(ctx context.Context, collection string, resp ...*http.Response) ([]IndexDefinition, error)
+	/*
+		ListRecordsWithContext - Returns a list of records in a collection with basic filtering, sorting, pagination and field projection.
+		Use key-value query parameters to filter fields. Fields are implicitly ANDed and values for the same field are implicitly ORed.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			collection: The name of the collection.
+			query: a struct pointer of valid query parameters for the endpoint, nil to send no query parameters
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListRecordsWithContext(ctx context.Context, collection string, query *ListRecordsQueryParams, resp ...*http.Response) ([]map[string]interface{}, error)
+	/*
+		PingWithContext - Returns the health status from the database.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	PingWithContext(ctx context.Context, resp ...*http.Response) (*PingResponse, error)
+	/*
+		PutRecordWithContext - Updates the record with a given key, either by inserting or replacing the record.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			collection: The name of the collection.
+			key: The key of the record.
+			body: Record to add to the collection, formatted as a JSON object.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	PutRecordWithContext(ctx context.Context, collection string, key string, body map[string]interface{}, resp ...*http.Response) (*Record, error)
+	/*
+		QueryRecordsWithContext - Returns a list of query records in a collection.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			collection: The name of the collection.
+			query: a struct pointer of valid query parameters for the endpoint, nil to send no query parameters
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	QueryRecordsWithContext(ctx context.Context, collection string, query *QueryRecordsQueryParams, resp ...*http.Response) ([]map[string]interface{}, error)
+	/*
+		TruncateRecordsWithContext - Deletes all the records in a collection.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			collection: The name of the collection.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	TruncateRecordsWithContext(ctx context.Context, collection string, resp ...*http.Response) error
+	// ListIndexesPages returns a Pager over the pages of ListIndexes, which returns a single page
+	ListIndexesPages(collection string) *sdkutil.Pager[IndexDefinition]
+	// ListIndexesAll returns the items of all pages of ListIndexes
+	ListIndexesAll(collection string) ([]IndexDefinition, error)
+	// ListIndexesAllWithContext - ListIndexesAll bound to ctx
+	ListIndexesAllWithContext(ctx context.Context, collection string) ([]IndexDefinition, error)
+	// ListRecordsPages returns a Pager over the pages of ListRecords, advancing the offset by the number of items of each page
+	ListRecordsPages(collection string, query *ListRecordsQueryParams) *sdkutil.Pager[map[string]interface{}]
+	// ListRecordsAll returns the items of all pages of ListRecords
+	ListRecordsAll(collection string, query *ListRecordsQueryParams) ([]map[string]interface{}, error)
+	// ListRecordsAllWithContext - ListRecordsAll bound to ctx
+	ListRecordsAllWithContext(ctx context.Context, collection string, query *ListRecordsQueryParams) ([]map[string]interface{}, error)
 }
@@ -0,0 +1,56 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kvstore
+
+import (
+	"context"
+
+	"github.com/splunk/go-dependencies/services"
+	sdkservices "github.com/splunk/splunk-cloud-sdk-go/services"
+	"github.com/splunk/splunk-cloud-sdk-go/util"
+)
+
+// ListRecordsStream returns an iterator over the records in a collection which decodes the records one at a
+// time as they are read from the response, such that large collections can be processed with bounded memory.
+// The iterator must be closed once it is no longer needed.
+func (s *Service) ListRecordsStream(collection string, query *ListRecordsQueryParams) (*util.JSONIterator[map[string]interface{}], error) {
+	return s.ListRecordsStreamWithContext(context.Background(), collection, query)
+}
+
+// ListRecordsStreamWithContext is ListRecordsStream with the request and reading of records bound to ctx
+func (s *Service) ListRecordsStreamWithContext(ctx context.Context, collection string, query *ListRecordsQueryParams) (*util.JSONIterator[map[string]interface{}], error) {
+	pp := struct {
+		Collection string
+	}{
+		Collection: collection,
+	}
+	// the tenant and region of ctx apply to the url as well as the request, which is not limited by the
+	// client's timeout as the results are read as they are streamed
+	client := s.withContext(sdkservices.WithStreamedResponse(ctx)).Client
+	u, err := client.BuildURLFromPathParams(util.ParseURLParams(query), serviceCluster, `/kvstore/v1beta1/collections/{{.Collection}}`, pp)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.Get(services.RequestParams{URL: u})
+	if err != nil {
+		if response != nil {
+			response.Body.Close()
+		}
+		return nil, err
+	}
+	return util.NewJSONArrayIterator[map[string]interface{}](response.Body), nil
+}
@@ -0,0 +1,356 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package services
+
+import (
+	_ "embed" // scloud's built-in configuration
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/pelletier/go-toml"
+	"github.com/splunk/splunk-cloud-sdk-go/idp"
+	"github.com/splunk/splunk-cloud-sdk-go/util"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// SettingsFileName is the name of the scloud settings file found in the home directory
+	SettingsFileName = ".scloud.toml"
+	// ContextFileName is the default name of the scloud context cache written by `scloud login`
+	ContextFileName = ".scloud_context"
+	// envPrefix prefixes the environment variable equivalent of each setting, e.g. SCLOUD_TENANT for tenant
+	envPrefix = "SCLOUD_"
+	// defaultEnv is the scloud environment used if the env setting is not set, as by scloud
+	defaultEnv = "prod"
+)
+
+// scloudDefaultConfig is scloud's built-in configuration, defining the deployment environments selected by the
+// env setting and the app profile scloud logs in to each with
+//
+//go:embed scloud_default.yaml
+var scloudDefaultConfig []byte
+
+// scloudEnvironment is the host of a deployment environment and the app profile scloud logs in to it with
+type scloudEnvironment struct {
+	// host is the root domain of the environment, e.g. "scp.splunk.com"
+	host string
+	// clientID is the app scloud logs in with, which the tokens cached by `scloud login` are keyed by
+	clientID string
+	// idpHost is the identity provider of the environment
+	idpHost string
+}
+
+// lookupScloudEnvironment returns the environment named name in scloud's built-in configuration
+func lookupScloudEnvironment(name string) (*scloudEnvironment, error) {
+	var defaults struct {
+		Environments map[string]struct {
+			APIService struct {
+				Host string `yaml:"host"`
+			} `yaml:"api-service"`
+			Profile string `yaml:"profile"`
+		} `yaml:"environments"`
+		Profiles map[string]map[string]string `yaml:"profiles"`
+	}
+	if err := yaml.Unmarshal(scloudDefaultConfig, &defaults); err != nil {
+		return nil, fmt.Errorf("services.LoadConfig: error reading scloud environments: %s", err)
+	}
+	env, ok := defaults.Environments[name]
+	if !ok {
+		return nil, fmt.Errorf("services.LoadConfig: unknown env: '%s'", name)
+	}
+	profile := defaults.Profiles[env.Profile]
+	return &scloudEnvironment{
+		host:     strings.TrimPrefix(env.APIService.Host, "api."),
+		clientID: profile["client_id"],
+		idpHost:  profile["idp_host"],
+	}, nil
+}
+
+// LoadConfigOptions are the (optional) sources used by LoadConfig
+type LoadConfigOptions struct {
+	// SettingsFile is the scloud settings file, `.scloud.toml` relative to SCLOUD_HOME if set or the home
+	// directory otherwise - as used by scloud
+	SettingsFile string
+	// ContextFile is the scloud context cache holding tokens from `scloud login`, by default SCLOUD_CACHE_PATH or
+	// `.scloud_context`, relative to SCLOUD_HOME if set or the home directory otherwise - as used by scloud
+	ContextFile string
+	// LookupEnv retrieves environment variables, os.LookupEnv by default
+	LookupEnv func(key string) (string, bool)
+}
+
+// LoadConfig resolves a Config from environment variables and the settings files used by scloud, such that a
+// program and scloud can share one configuration. Each setting is taken from the first of:
+//
+//  1. the environment variable named SCLOUD_ followed by the setting in upper case with "-" replaced by "_",
+//     e.g. SCLOUD_TENANT or SCLOUD_HOST_URL
+//  2. the settings file, `$SCLOUD_HOME/.scloud.toml` or `$HOME/.scloud.toml` by default, as written by
+//     `scloud config set`
+//  3. for host, client-id and the IdP, the scloud environment named by the env setting, "prod" by default, and
+//     the app profile scloud logs in to it with - such that the tokens cached by `scloud login` are found
+//
+// The settings are: env (e.g. "prod" or "staging-scs"), tenant, region, tenant-scoped, host (the root domain,
+// e.g. "scp.splunk.com"), host-url (a URL to send all requests to, e.g. "https://localhost:8443"), timeout (in
+// seconds, or a duration such as "30s"), retry-requests, retry-num, retry-interval (in milliseconds), ca-cert
+// (a PEM file of CA certificates to trust), insecure, auth-url (overrides the IdP of the env, e.g.
+// "https://auth.scp.splunk.com"), client-id and scope.
+//
+// Credentials are taken from the first of: SCLOUD_TOKEN (an access token), SCLOUD_CLIENT_SECRET (the client
+// credentials flow), SCLOUD_PRIVATE_KEY_FILE (an EC private key in PEM or JWK format signing client assertions),
+// SCLOUD_REFRESH_TOKEN, and the tokens cached for client-id and tenant by `scloud login`. Secrets are only read
+// from the environment, never from the settings file.
+func LoadConfig(opts *LoadConfigOptions) (*Config, error) {
+	if opts == nil {
+		opts = &LoadConfigOptions{}
+	}
+	lookupEnv := opts.LookupEnv
+	if lookupEnv == nil {
+		lookupEnv = os.LookupEnv
+	}
+	settingsFile := opts.SettingsFile
+	if settingsFile == "" {
+		var err error
+		if settingsFile, err = scloudHomeFile(SettingsFileName, lookupEnv); err != nil {
+			return nil, err
+		}
+	}
+	s, err := loadSettings(settingsFile, lookupEnv, opts.SettingsFile == "")
+	if err != nil {
+		return nil, err
+	}
+
+	envName := s.get("env")
+	if envName == "" {
+		envName = defaultEnv
+	}
+	env, err := lookupScloudEnvironment(envName)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &Config{
+		Tenant: s.get("tenant"),
+		Region: s.get("region"),
+	}
+	if config.TenantScoped, err = s.getBool("tenant-scoped"); err != nil {
+		return nil, err
+	}
+	if hostURL := s.get("host-url"); hostURL != "" {
+		u, err := url.Parse(hostURL)
+		if err != nil || u.Host == "" {
+			return nil, fmt.Errorf("services.LoadConfig: invalid host-url: '%s'", hostURL)
+		}
+		config.Scheme = u.Scheme
+		config.OverrideHost = u.Host
+	} else if host := s.get("host"); host != "" {
+		config.Host = host
+	} else {
+		config.Host = env.host
+	}
+	if timeout := s.get("timeout"); timeout != "" {
+		if config.Timeout, err = parseSeconds(timeout); err != nil {
+			return nil, fmt.Errorf("services.LoadConfig: invalid timeout: '%s'", timeout)
+		}
+	}
+	if config.RetryRequests, err = s.getBool("retry-requests"); err != nil {
+		return nil, err
+	}
+	if s.get("retry-num") != "" || s.get("retry-interval") != "" {
+		retryConfig := &ConfigurableRetryConfig{RetryNum: defaultMaxRetryCount, Interval: defaultIntervalMillis}
+		if retryNum := s.get("retry-num"); retryNum != "" {
+			n, err := strconv.ParseUint(retryNum, 10, 0)
+			if err != nil {
+				return nil, fmt.Errorf("services.LoadConfig: invalid retry-num: '%s'", retryNum)
+			}
+			retryConfig.RetryNum = uint(n)
+		}
+		if interval := s.get("retry-interval"); interval != "" {
+			if retryConfig.Interval, err = strconv.Atoi(interval); err != nil {
+				return nil, fmt.Errorf("services.LoadConfig: invalid retry-interval: '%s'", interval)
+			}
+		}
+		config.RetryConfig.ConfigurableRetryConfig = retryConfig
+	}
+	insecure, err := s.getBool("insecure")
+	if err != nil {
+		return nil, err
+	}
+	if caCert := s.get("ca-cert"); caCert != "" || insecure {
+		config.Transport = &util.TransportConfig{InsecureSkipVerify: insecure}
+		if caCert != "" && !insecure {
+			config.Transport.RootCAFiles = []string{caCert}
+		}
+	}
+
+	// Credentials
+	if token, ok := lookupEnv(envPrefix + "TOKEN"); ok && token != "" {
+		config.Token = token
+		return config, nil
+	}
+	clientID := s.get("client-id")
+	if clientID == "" {
+		clientID = env.clientID
+	}
+	// auth-url overrides the IdP of the env, as it does for scloud
+	idpHost, authURL := env.idpHost, s.get("auth-url")
+	hostURLConfig := idp.HostURLConfig{Tenant: config.Tenant, Region: config.Region, TenantScoped: config.TenantScoped}
+	if secret, ok := lookupEnv(envPrefix + "CLIENT_SECRET"); ok && secret != "" {
+		config.TokenRetriever = idp.NewClientCredentialsRetriever(clientID, secret, s.get("scope"), idpHost, authURL, hostURLConfig)
+		return config, nil
+	}
+	if keyFile, ok := lookupEnv(envPrefix + "PRIVATE_KEY_FILE"); ok && keyFile != "" {
+		key, err := idp.LoadSigningKey(keyFile, "")
+		if err != nil {
+			return nil, fmt.Errorf("services.LoadConfig: error loading private key: %s", err)
+		}
+		config.TokenRetriever = idp.NewJWTAssertionRetriever(clientID, s.get("scope"), key, idpHost, authURL, hostURLConfig)
+		return config, nil
+	}
+	scope := s.get("scope")
+	if scope == "" {
+		scope = idp.DefaultRefreshScope
+	}
+	if refreshToken, ok := lookupEnv(envPrefix + "REFRESH_TOKEN"); ok && refreshToken != "" {
+		config.TokenRetriever = idp.NewRefreshTokenRetriever(clientID, scope, refreshToken, idpHost, authURL, hostURLConfig)
+		return config, nil
+	}
+	contextFile := opts.ContextFile
+	if contextFile == "" {
+		if contextFile, err = scloudHomeFile(ContextFileName, lookupEnv); err != nil {
+			return nil, err
+		}
+	}
+	tctx, err := loadCachedContext(contextFile, clientID, config.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	if tctx != nil && tctx.RefreshToken != "" {
+		config.TokenRetriever = idp.NewRefreshTokenRetriever(clientID, scope, tctx.RefreshToken, idpHost, authURL, hostURLConfig)
+		return config, nil
+	}
+	if tctx != nil && tctx.AccessToken != "" {
+		config.Token = tctx.AccessToken
+		return config, nil
+	}
+	return nil, errors.New("services.LoadConfig: no credentials found, set SCLOUD_TOKEN, SCLOUD_CLIENT_SECRET, SCLOUD_PRIVATE_KEY_FILE or SCLOUD_REFRESH_TOKEN or use `scloud login`")
+}
+
+// settings are the values of each setting, resolved in order of precedence
+type settings struct {
+	lookupEnv func(key string) (string, bool)
+	// file is the settings file, nil if there is none
+	file *toml.Tree
+}
+
+// loadSettings reads the settings in file, which may not exist if optional
+func loadSettings(file string, lookupEnv func(key string) (string, bool), optional bool) (*settings, error) {
+	s := &settings{lookupEnv: lookupEnv}
+	tree, err := toml.LoadFile(file)
+	if err != nil {
+		if optional && os.IsNotExist(err) {
+			return s, nil
+		}
+		return nil, fmt.Errorf("services.LoadConfig: error reading settings file: %s", err)
+	}
+	s.file = tree
+	return s, nil
+}
+
+// get returns the value of key from the environment or the settings file, or "" if not set
+func (s *settings) get(key string) string {
+	if v, ok := s.lookupEnv(envPrefix + strings.ToUpper(strings.ReplaceAll(key, "-", "_"))); ok && v != "" {
+		return v
+	}
+	if s.file == nil {
+		return ""
+	}
+	if v := s.file.GetPath([]string{key}); v != nil {
+		if _, isTree := v.(*toml.Tree); !isTree {
+			return fmt.Sprint(v)
+		}
+	}
+	return ""
+}
+
+// getBool returns the value of key as a bool, false if not set
+func (s *settings) getBool(key string) (bool, error) {
+	v := s.get(key)
+	if v == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("services.LoadConfig: invalid %s: '%s'", key, v)
+	}
+	return b, nil
+}
+
+// parseSeconds parses a number of seconds, as used by scloud, or a duration such as "30s"
+func parseSeconds(v string) (time.Duration, error) {
+	if seconds, err := strconv.Atoi(v); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	return time.ParseDuration(v)
+}
+
+// scloudHomeFile returns the location of a file used by scloud: name, or for the context cache SCLOUD_CACHE_PATH
+// if set, relative to SCLOUD_HOME if set or the home directory otherwise
+func scloudHomeFile(name string, lookupEnv func(key string) (string, bool)) (string, error) {
+	file := name
+	if cachePath, ok := lookupEnv("SCLOUD_CACHE_PATH"); ok && cachePath != "" && name == ContextFileName {
+		file = cachePath
+	}
+	if filepath.IsAbs(file) {
+		return file, nil
+	}
+	root, ok := lookupEnv("SCLOUD_HOME")
+	if !ok {
+		var err error
+		if root, err = homedir.Dir(); err != nil {
+			return "", fmt.Errorf("services.LoadConfig: error finding home directory: %s", err)
+		}
+	}
+	return filepath.Join(root, file), nil
+}
+
+// loadCachedContext returns the context cached by `scloud login` for clientID and tenant, or nil if none
+func loadCachedContext(file string, clientID string, tenant string) (*idp.Context, error) {
+	if clientID == "" || tenant == "" {
+		return nil, nil
+	}
+	tree, err := toml.LoadFile(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("services.LoadConfig: error reading context file: %s", err)
+	}
+	cached, ok := tree.GetPath([]string{clientID, tenant}).(*toml.Tree)
+	if !ok {
+		return nil, nil
+	}
+	tctx := &idp.Context{}
+	tctx.AccessToken, _ = cached.Get("access_token").(string)
+	tctx.RefreshToken, _ = cached.Get("refresh_token").(string)
+	return tctx, nil
+}
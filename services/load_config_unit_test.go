@@ -0,0 +1,236 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package services
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/splunk/splunk-cloud-sdk-go/idp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSettings = `
+tenant = "mytenant"
+host = "staging.scp.splunk.com"
+timeout = "30"
+tenant-scoped = "true"
+region = "region1"
+client-id = "scloudclient"
+auth-url = "https://auth.staging.scp.splunk.com"
+`
+
+const testContext = `
+[scloudclient.mytenant]
+access_token = "cached.access.token"
+expires_in = 3600
+token_type = "Bearer"
+
+[scloudclient.othertenant]
+access_token = "other.access.token"
+refresh_token = "other.refresh.token"
+`
+
+// writeConfigFiles writes the settings and context files used by tests, returning their paths
+func writeConfigFiles(t *testing.T) (string, string) {
+	dir := t.TempDir()
+	settingsFile := filepath.Join(dir, SettingsFileName)
+	require.NoError(t, ioutil.WriteFile(settingsFile, []byte(testSettings), 0600))
+	contextFile := filepath.Join(dir, ContextFileName)
+	require.NoError(t, ioutil.WriteFile(contextFile, []byte(testContext), 0600))
+	return settingsFile, contextFile
+}
+
+// envMap looks up environment variables from a map rather than the process environment
+func envMap(env map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		v, ok := env[key]
+		return v, ok
+	}
+}
+
+func TestLoadConfigSettingsFile(t *testing.T) {
+	settingsFile, contextFile := writeConfigFiles(t)
+	config, err := LoadConfig(&LoadConfigOptions{SettingsFile: settingsFile, ContextFile: contextFile, LookupEnv: envMap(nil)})
+	require.NoError(t, err)
+	assert.Equal(t, "mytenant", config.Tenant)
+	assert.Equal(t, "region1", config.Region)
+	assert.True(t, config.TenantScoped)
+	assert.Equal(t, "staging.scp.splunk.com", config.Host)
+	assert.Equal(t, 30*time.Second, config.Timeout)
+	assert.False(t, config.RetryRequests)
+	assert.Nil(t, config.Transport)
+	// Cached by scloud login for the client
+	assert.Equal(t, "cached.access.token", config.Token)
+	assert.Nil(t, config.TokenRetriever)
+}
+
+func TestLoadConfigEnvironment(t *testing.T) {
+	// The settings file is optional in SCLOUD_HOME
+	env := envMap(map[string]string{
+		"SCLOUD_HOME":           t.TempDir(),
+		"SCLOUD_TENANT":         "localtenant",
+		"SCLOUD_HOST_URL":       "http://localhost:8080",
+		"SCLOUD_RETRY_REQUESTS": "true",
+		"SCLOUD_RETRY_NUM":      "3",
+		"SCLOUD_CA_CERT":        "/etc/ssl/local.pem",
+		"SCLOUD_TOKEN":          "env.access.token",
+	})
+	config, err := LoadConfig(&LoadConfigOptions{LookupEnv: env})
+	require.NoError(t, err)
+	assert.Equal(t, "localtenant", config.Tenant)
+	assert.Equal(t, "http", config.Scheme)
+	assert.Equal(t, "localhost:8080", config.OverrideHost)
+	assert.Empty(t, config.Host)
+	assert.True(t, config.RetryRequests)
+	require.NotNil(t, config.RetryConfig.ConfigurableRetryConfig)
+	assert.Equal(t, uint(3), config.RetryConfig.ConfigurableRetryConfig.RetryNum)
+	assert.Equal(t, defaultIntervalMillis, config.RetryConfig.ConfigurableRetryConfig.Interval)
+	assert.Equal(t, "env.access.token", config.Token)
+	require.NotNil(t, config.Transport)
+	assert.Equal(t, []string{"/etc/ssl/local.pem"}, config.Transport.RootCAFiles)
+}
+
+func TestLoadConfigSCloudHome(t *testing.T) {
+	settingsFile, _ := writeConfigFiles(t)
+	env := envMap(map[string]string{"SCLOUD_HOME": filepath.Dir(settingsFile)})
+	config, err := LoadConfig(&LoadConfigOptions{LookupEnv: env})
+	require.NoError(t, err)
+	assert.Equal(t, "mytenant", config.Tenant)
+	assert.Equal(t, "cached.access.token", config.Token)
+}
+
+func TestLoadConfigEnvironmentPrecedence(t *testing.T) {
+	settingsFile, contextFile := writeConfigFiles(t)
+	env := envMap(map[string]string{
+		"SCLOUD_TENANT":        "othertenant",
+		"SCLOUD_HOST":          "example.com",
+		"SCLOUD_TIMEOUT":       "1m",
+		"SCLOUD_TENANT_SCOPED": "false",
+	})
+	config, err := LoadConfig(&LoadConfigOptions{SettingsFile: settingsFile, ContextFile: contextFile, LookupEnv: env})
+	require.NoError(t, err)
+	assert.Equal(t, "othertenant", config.Tenant)
+	assert.Equal(t, "example.com", config.Host)
+	assert.Equal(t, time.Minute, config.Timeout)
+	assert.False(t, config.TenantScoped)
+	// The cached refresh token is used to retrieve new access tokens
+	require.IsType(t, &idp.RefreshTokenRetriever{}, config.TokenRetriever)
+	tr := config.TokenRetriever.(*idp.RefreshTokenRetriever)
+	assert.Equal(t, "scloudclient", tr.ClientID)
+	assert.Equal(t, "other.refresh.token", tr.RefreshToken.ClearText())
+	assert.Empty(t, config.Token)
+}
+
+func TestLoadConfigClientCredentials(t *testing.T) {
+	env := envMap(map[string]string{
+		"SCLOUD_TENANT":        "mytenant",
+		"SCLOUD_CLIENT_ID":     "myclient",
+		"SCLOUD_CLIENT_SECRET": "mysecret",
+		"SCLOUD_SCOPE":         "backend_service",
+	})
+	settingsFile, _ := writeConfigFiles(t)
+	config, err := LoadConfig(&LoadConfigOptions{SettingsFile: settingsFile, LookupEnv: env})
+	require.NoError(t, err)
+	require.IsType(t, &idp.ClientCredentialsRetriever{}, config.TokenRetriever)
+	tr := config.TokenRetriever.(*idp.ClientCredentialsRetriever)
+	assert.Equal(t, "myclient", tr.ClientID)
+	assert.Equal(t, "mysecret", tr.ClientSecret.ClearText())
+	assert.Equal(t, "backend_service", tr.Scope)
+	assert.Equal(t, "https://auth.staging.scp.splunk.com/", tr.OverrideAuthURL)
+
+	_, err = LoadConfig(&LoadConfigOptions{SettingsFile: filepath.Join(t.TempDir(), "missing.toml"), LookupEnv: env})
+	assert.Error(t, err, "an explicitly specified settings file must exist")
+	// Without auth-url the IdP of the env is used, prod by default
+	emptySettings := filepath.Join(t.TempDir(), SettingsFileName)
+	require.NoError(t, ioutil.WriteFile(emptySettings, nil, 0600))
+	config, err = LoadConfig(&LoadConfigOptions{SettingsFile: emptySettings, LookupEnv: env})
+	require.NoError(t, err)
+	tr = config.TokenRetriever.(*idp.ClientCredentialsRetriever)
+	assert.Equal(t, "https://auth.scp.splunk.com/", tr.ProviderHost)
+	assert.Empty(t, tr.OverrideAuthURL)
+	assert.Equal(t, "scp.splunk.com", config.Host)
+}
+
+func TestLoadConfigSCloudEnv(t *testing.T) {
+	// The tokens cached by scloud login for the app profile of the env are found without setting client-id
+	dir := t.TempDir()
+	contextFile := filepath.Join(dir, ContextFileName)
+	require.NoError(t, ioutil.WriteFile(contextFile, []byte(`
+[0oa2348c6uNuMceOx2p7.mytenant]
+access_token = "staging.access.token"
+refresh_token = "staging.refresh.token"
+`), 0600))
+	env := envMap(map[string]string{"SCLOUD_HOME": dir, "SCLOUD_ENV": "staging", "SCLOUD_TENANT": "mytenant"})
+	config, err := LoadConfig(&LoadConfigOptions{LookupEnv: env})
+	require.NoError(t, err)
+	assert.Equal(t, "staging.scp.splunk.com", config.Host)
+	require.IsType(t, &idp.RefreshTokenRetriever{}, config.TokenRetriever)
+	tr := config.TokenRetriever.(*idp.RefreshTokenRetriever)
+	assert.Equal(t, "0oa2348c6uNuMceOx2p7", tr.ClientID)
+	assert.Equal(t, "staging.refresh.token", tr.RefreshToken.ClearText())
+	assert.Equal(t, "https://auth.staging.scp.splunk.com/", tr.ProviderHost)
+
+	_, err = LoadConfig(&LoadConfigOptions{LookupEnv: envMap(map[string]string{"SCLOUD_HOME": dir, "SCLOUD_ENV": "nowhere"})})
+	assert.EqualError(t, err, "services.LoadConfig: unknown env: 'nowhere'")
+}
+
+func TestLoadConfigPrivateKey(t *testing.T) {
+	key, err := idp.GenerateSigningKey()
+	require.NoError(t, err)
+	data, err := key.PEM()
+	require.NoError(t, err)
+	keyFile := filepath.Join(t.TempDir(), "key.pem")
+	require.NoError(t, ioutil.WriteFile(keyFile, data, 0600))
+	env := envMap(map[string]string{
+		"SCLOUD_TENANT":           "mytenant",
+		"SCLOUD_CLIENT_ID":        "myprincipal",
+		"SCLOUD_PRIVATE_KEY_FILE": keyFile,
+		"SCLOUD_SCOPE":            "backend_service",
+	})
+	settingsFile, _ := writeConfigFiles(t)
+	config, err := LoadConfig(&LoadConfigOptions{SettingsFile: settingsFile, LookupEnv: env})
+	require.NoError(t, err)
+	require.IsType(t, &idp.JWTAssertionRetriever{}, config.TokenRetriever)
+	tr := config.TokenRetriever.(*idp.JWTAssertionRetriever)
+	assert.Equal(t, "myprincipal", tr.ClientID)
+	assert.Equal(t, "backend_service", tr.Scope)
+	assert.Equal(t, []string{key.KeyID}, tr.KeyIDs())
+}
+
+func TestLoadConfigNoCredentials(t *testing.T) {
+	settingsFile, _ := writeConfigFiles(t)
+	env := envMap(map[string]string{"SCLOUD_TENANT": "unknowntenant"})
+	_, err := LoadConfig(&LoadConfigOptions{SettingsFile: settingsFile, ContextFile: filepath.Join(t.TempDir(), ContextFileName), LookupEnv: env})
+	assert.Error(t, err)
+}
+
+func TestLoadConfigInvalidSettings(t *testing.T) {
+	settingsFile, contextFile := writeConfigFiles(t)
+	for _, env := range []map[string]string{
+		{"SCLOUD_TIMEOUT": "soon"},
+		{"SCLOUD_TENANT_SCOPED": "maybe"},
+		{"SCLOUD_HOST_URL": "localhost"},
+		{"SCLOUD_RETRY_NUM": "-1"},
+	} {
+		_, err := LoadConfig(&LoadConfigOptions{SettingsFile: settingsFile, ContextFile: contextFile, LookupEnv: envMap(env)})
+		assert.Error(t, err, "%v", env)
+	}
+}
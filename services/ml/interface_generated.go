@@ -19,7 +19,10 @@
 package ml
 
 import (
+	"context"
 	"net/http"
+
+	sdkutil "github.com/splunk/splunk-cloud-sdk-go/util"
 )
 
 // ServicerGenerated represents the interface for implementing all endpoints for this service
@@ -241,4 +244,271 @@ type ServicerGenerated interface {
 			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
 	*/
 	ListWorkflows(resp ...*http.Response) ([]WorkflowsGetResponse, error)
+	/*
+		CreateWorkflowWithContext - Creates a workflow configuration.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			workflow: Workflow configuration to be created.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	CreateWorkflowWithContext(ctx context.Context, workflow Workflow, resp ...*http.Response) (*Workflow, error)
+	/*
+		CreateWorkflowBuildWithContext - Creates a workflow build.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			id: The workflow ID.
+			workflowBuild: Input data used to build the workflow.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	CreateWorkflowBuildWithContext(ctx context.Context, id string, workflowBuild WorkflowBuild, resp ...*http.Response) (*WorkflowBuild, error)
+	/*
+		CreateWorkflowDeploymentWithContext - Creates a workflow deployment.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			id: The workflow ID.
+			buildId: The workflow build ID.
+			workflowDeployment: Input data used to build the workflow deployment.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	CreateWorkflowDeploymentWithContext(ctx context.Context, id string, buildId string, workflowDeployment WorkflowDeployment, resp ...*http.Response) (*WorkflowDeployment, error)
+	/*
+		CreateWorkflowInferenceWithContext - Creates a workflow inference request.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			id: The workflow ID.
+			buildId: The workflow build ID.
+			deploymentId: The workflow deployment ID.
+			workflowInference: Input data to the inference request.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	CreateWorkflowInferenceWithContext(ctx context.Context, id string, buildId string, deploymentId string, workflowInference WorkflowInference, resp ...*http.Response) (*WorkflowInference, error)
+	/*
+		CreateWorkflowRunWithContext - Creates a workflow run.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			id: The workflow ID.
+			buildId: The workflow build ID.
+			workflowRun: Input data used to build the workflow.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	CreateWorkflowRunWithContext(ctx context.Context, id string, buildId string, workflowRun WorkflowRun, resp ...*http.Response) (*WorkflowRun, error)
+	/*
+		CreateWorkflowStreamDeploymentWithContext - Creates a workflow streaming deployment.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			id: The workflow ID.
+			buildId: The workflow build ID.
+			workflowStreamDeployment: Configuration for the workflow streaming deployment.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	CreateWorkflowStreamDeploymentWithContext(ctx context.Context, id string, buildId string, workflowStreamDeployment WorkflowStreamDeployment, resp ...*http.Response) (*WorkflowStreamDeployment, error)
+	/*
+		DeleteWorkflowWithContext - Removes a workflow configuration.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			id: The workflow ID.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	DeleteWorkflowWithContext(ctx context.Context, id string, resp ...*http.Response) error
+	/*
+		DeleteWorkflowBuildWithContext - Removes a workflow build.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			id: The workflow ID.
+			buildId: The workflow build ID.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	DeleteWorkflowBuildWithContext(ctx context.Context, id string, buildId string, resp ...*http.Response) error
+	/*
+		DeleteWorkflowDeploymentWithContext - Removes a workflow deployment.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			id: The workflow ID.
+			buildId: The workflow build ID.
+			deploymentId: The workflow deployment ID.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	DeleteWorkflowDeploymentWithContext(ctx context.Context, id string, buildId string, deploymentId string, resp ...*http.Response) error
+	/*
+		DeleteWorkflowRunWithContext - Removes a workflow run.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			id: The workflow ID.
+			buildId: The workflow build ID.
+			runId: The workflow run ID.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	DeleteWorkflowRunWithContext(ctx context.Context, id string, buildId string, runId string, resp ...*http.Response) error
+	/*
+		DeleteWorkflowStreamDeploymentWithContext - Removes a workflow streaming deployment.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			id: The workflow ID.
+			buildId: The workflow build ID.
+			streamDeploymentId: The workflow streaming deployment ID.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	DeleteWorkflowStreamDeploymentWithContext(ctx context.Context, id string, buildId string, streamDeploymentId string, resp ...*http.Response) error
+	/*
+		GetWorkflowWithContext - Returns a workflow configuration.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			id: The workflow ID.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetWorkflowWithContext(ctx context.Context, id string, resp ...*http.Response) (*Workflow, error)
+	/*
+		GetWorkflowBuildWithContext - Returns the status of a workflow build.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			id: The workflow ID.
+			buildId: The workflow build ID.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetWorkflowBuildWithContext(ctx context.Context, id string, buildId string, resp ...*http.Response) (*WorkflowBuild, error)
+	/*
+		GetWorkflowBuildErrorWithContext - Returns a list of workflow errors.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			id: The workflow ID.
+			buildId: The workflow build ID.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetWorkflowBuildErrorWithContext(ctx context.Context, id string, buildId string, resp ...*http.Response) (*WorkflowBuildError, error)
+	/*
+		GetWorkflowBuildLogWithContext - Returns the logs from a workflow build.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			id: The workflow ID.
+			buildId: The workflow build ID.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetWorkflowBuildLogWithContext(ctx context.Context, id string, buildId string, resp ...*http.Response) (*WorkflowBuildLog, error)
+	/*
+		GetWorkflowDeploymentWithContext - Returns the status of a workflow deployment.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			id: The workflow ID.
+			buildId: The workflow build ID.
+			deploymentId: The workflow deployment ID.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetWorkflowDeploymentWithContext(ctx context.Context, id string, buildId string, deploymentId string, resp ...*http.Response) (*WorkflowDeployment, error)
+	/*
+		GetWorkflowDeploymentErrorWithContext - Returns a list of workflow deployment errors.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			id: The workflow ID.
+			buildId: The workflow build ID.
+			deploymentId: The workflow deployment ID.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetWorkflowDeploymentErrorWithContext(ctx context.Context, id string, buildId string, deploymentId string, resp ...*http.Response) (*WorkflowDeploymentError, error)
+	/*
+		GetWorkflowDeploymentLogWithContext - Returns the logs from a workflow deployment.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			id: The workflow ID.
+			buildId: The workflow build ID.
+			deploymentId: The workflow deployment ID.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetWorkflowDeploymentLogWithContext(ctx context.Context, id string, buildId string, deploymentId string, resp ...*http.Response) (*WorkflowDeploymentLog, error)
+	/*
+		GetWorkflowRunWithContext - Returns the status of a workflow run.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			id: The workflow ID.
+			buildId: The workflow build ID.
+			runId: The workflow run ID.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetWorkflowRunWithContext(ctx context.Context, id string, buildId string, runId string, resp ...*http.Response) (*WorkflowRun, error)
+	/*
+		GetWorkflowRunErrorWithContext - Returns the errors for a workflow run.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			id: The workflow ID.
+			buildId: The workflow build ID.
+			runId: The workflow run ID.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetWorkflowRunErrorWithContext(ctx context.Context, id string, buildId string, runId string, resp ...*http.Response) (*WorkflowRunError, error)
+	/*
+		GetWorkflowRunLogWithContext - Returns the logs for a workflow run.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			id: The workflow ID.
+			buildId: The workflow build ID.
+			runId: The workflow run ID.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetWorkflowRunLogWithContext(ctx context.Context, id string, buildId string, runId string, resp ...*http.Response) (*WorkflowRunLog, error)
+	/*
+		GetWorkflowStreamDeploymentWithContext - Returns the status of a workflow streaming deployment.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			id: The workflow ID.
+			buildId: The workflow build ID.
+			streamDeploymentId: The workflow streaming deployment ID.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetWorkflowStreamDeploymentWithContext(ctx context.Context, id string, buildId string, streamDeploymentId string, resp ...*http.Response) (*WorkflowStreamDeployment, error)
+	/*
+		ListWorkflowBuildsWithContext - Returns a list of workflow builds.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			id: The workflow ID.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListWorkflowBuildsWithContext(ctx context.Context, id string, resp ...*http.Response) ([]WorkflowBuild, error)
+	/*
+		ListWorkflowDeploymentsWithContext - Returns a list of workflow deployments.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			id: The workflow ID.
+			buildId: The workflow build ID.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListWorkflowDeploymentsWithContext(ctx context.Context, id string, buildId string, resp ...*http.Response) ([]WorkflowDeployment, error)
+	/*
+		ListWorkflowRunsWithContext - Returns a list of workflow runs.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			id: The workflow ID.
+			buildId: The workflow build ID.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListWorkflowRunsWithContext(ctx context.Context, id string, buildId string, resp ...*http.Response) ([]WorkflowRun, error)
+	/*
+		ListWorkflowsWithContext - Returns a list of workflow configurations.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListWorkflowsWithContext(ctx context.Context, resp ...*http.Response) ([]WorkflowsGetResponse, error)
+	// ListWorkflowBuildsPages returns a Pager over the pages of ListWorkflowBuilds, which returns a single page
+	ListWorkflowBuildsPages(id string) *sdkutil.Pager[WorkflowBuild]
+	// ListWorkflowBuildsAll returns the items of all pages of ListWorkflowBuilds
+	ListWorkflowBuildsAll(id string) ([]WorkflowBuild, error)
+	// ListWorkflowBuildsAllWithContext - ListWorkflowBuildsAll bound to ctx
+	ListWorkflowBuildsAllWithContext(ctx context.Context, id string) ([]WorkflowBuild, error)
+	// ListWorkflowDeploymentsPages returns a Pager over the pages of ListWorkflowDeployments, which returns a single page
+	ListWorkflowDeploymentsPages(id string, buildId string) *sdkutil.Pager[WorkflowDeployment]
+	// ListWorkflowDeploymentsAll returns the items of all pages of ListWorkflowDeployments
+	ListWorkflowDeploymentsAll(id string, buildId string) ([]WorkflowDeployment, error)
+	// ListWorkflowDeploymentsAllWithContext - ListWorkflowDeploymentsAll bound to ctx
+	ListWorkflowDeploymentsAllWithContext(ctx context.Context, id string, buildId string) ([]WorkflowDeployment, error)
+	// ListWorkflowRunsPages returns a Pager over the pages of ListWorkflowRuns, which returns a single page
+	ListWorkflowRunsPages(id string, buildId string) *sdkutil.Pager[WorkflowRun]
+	// ListWorkflowRunsAll returns the items of all pages of ListWorkflowRuns
+	ListWorkflowRunsAll(id string, buildId string) ([]WorkflowRun, error)
+	// ListWorkflowRunsAllWithContext - ListWorkflowRunsAll bound to ctx
+	ListWorkflowRunsAllWithContext(ctx context.Context, id string, buildId string) ([]WorkflowRun, error)
+	// ListWorkflowsPages returns a Pager over the pages of ListWorkflows, which returns a single page
+	ListWorkflowsPages() *sdkutil.Pager[WorkflowsGetResponse]
+	// ListWorkflowsAll returns the items of all pages of ListWorkflows
+	ListWorkflowsAll() ([]WorkflowsGetResponse, error)
+	// ListWorkflowsAllWithContext - ListWorkflowsAll bound to ctx
+	ListWorkflowsAllWithContext(ctx context.Context) ([]WorkflowsGetResponse, error)
 }
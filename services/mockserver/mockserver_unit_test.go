@@ -0,0 +1,197 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package mockserver
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	gdepservices "github.com/splunk/go-dependencies/services"
+	"github.com/splunk/splunk-cloud-sdk-go/services"
+	"github.com/splunk/splunk-cloud-sdk-go/services/identity"
+	"github.com/splunk/splunk-cloud-sdk-go/services/search"
+	"github.com/splunk/splunk-cloud-sdk-go/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var allServices = []string{"action", "appregistry", "catalog", "collect", "forwarders", "identity", "ingest", "kvstore", "ml", "provisioner", "search", "streams"}
+
+func newTestServer(t *testing.T, svcs ...string) (*Server, *services.BaseClient) {
+	server, err := NewServiceServer(svcs...)
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+	client, err := services.NewClient(server.Config("mytenant"))
+	require.NoError(t, err)
+	return server, client
+}
+
+func TestServiceSpecs(t *testing.T) {
+	for _, svc := range allServices {
+		spec, err := ServiceSpec(svc)
+		require.NoError(t, err, svc)
+		routes := spec.routes()
+		assert.NotEmpty(t, routes, svc)
+		// every operation has a response example conforming to its schema
+		for _, rt := range routes {
+			_, example := rt.example()
+			resp := spec.response(rt.op.Responses[successCode(rt.op)])
+			if resp == nil || resp.Content["application/json"] == nil || example == nil {
+				continue
+			}
+			errs := spec.validate(roundTrip(t, example), resp.Content["application/json"].Schema, "body")
+			assert.Empty(t, errs, "%s.%s", svc, rt.op.OperationID)
+		}
+	}
+	_, err := ServiceSpec("nosuchservice")
+	assert.Error(t, err)
+}
+
+func TestServerExampleResponses(t *testing.T) {
+	server, client := newTestServer(t, "identity", "search")
+	info, err := identity.NewService(client).ValidateToken(nil)
+	require.NoError(t, err)
+	assert.NotEmpty(t, info.Name)
+
+	var resp http.Response
+	job, err := search.NewService(client).CreateJob(search.SearchJob{Query: "| from main"}, &resp)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.NotNil(t, job)
+	assert.Equal(t, []string{"identity.validateToken", "search.createJob"}, server.Operations())
+	assert.NoError(t, server.Check())
+}
+
+func TestServerValidation(t *testing.T) {
+	server, client := newTestServer(t, "identity", "search")
+
+	// the freshness of a search job is at most 72 hours
+	freshness := int32(300000)
+	_, err := search.NewService(client).CreateJob(search.SearchJob{Query: "| from main", RequiredFreshness: &freshness})
+	var httpErr *util.HTTPError
+	require.True(t, errors.As(err, &httpErr))
+	assert.Equal(t, http.StatusBadRequest, httpErr.HTTPStatusCode)
+	assert.Equal(t, "invalid_request", httpErr.Code)
+	assert.Contains(t, httpErr.Message, "body.requiredFreshness must be at most 259200")
+
+	// member names have a minimum length
+	_, err = identity.NewService(client).AddMember(identity.AddMemberBody{Name: "x"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "body.name must be at least 2 characters")
+
+	require.Equal(t, 2, len(server.Violations()))
+	assert.True(t, errors.Is(server.Check(), ErrViolations))
+	assert.Empty(t, server.Operations())
+
+	// unknown paths are not found
+	u, err := client.BuildURLFromPathParams(nil, "api", "/nosuchservice/v1/things", nil)
+	require.NoError(t, err)
+	_, err = client.Get(gdepservices.RequestParams{URL: u})
+	require.True(t, errors.As(err, &httpErr))
+	assert.Equal(t, http.StatusNotFound, httpErr.HTTPStatusCode)
+
+	server.Reset()
+	assert.NoError(t, server.Check())
+}
+
+func TestServerFixtures(t *testing.T) {
+	server, client := newTestServer(t, "search")
+	server.SetFixture("GetJob", Fixture{Body: search.SearchJob{Query: "| from main", Sid: strPtr("sid1")}})
+	job, err := search.NewService(client).GetJob("sid1")
+	require.NoError(t, err)
+	assert.Equal(t, "sid1", *job.Sid)
+
+	server.SetFixture("search.getJob", Fixture{StatusCode: http.StatusNotFound, Body: map[string]string{"code": "not_found", "message": "no such job"}})
+	_, err = search.NewService(client).GetJob("sid1")
+	var httpErr *util.HTTPError
+	require.True(t, errors.As(err, &httpErr))
+	assert.Equal(t, http.StatusNotFound, httpErr.HTTPStatusCode)
+	assert.Equal(t, "no such job", httpErr.Message)
+
+	server.HandleFunc("deleteJob", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	_, err = search.NewService(client).DeleteJob(search.DeleteSearchJob{Query: strPtr("| from main")})
+	require.True(t, errors.As(err, &httpErr))
+	assert.Equal(t, http.StatusTeapot, httpErr.HTTPStatusCode)
+}
+
+func TestSchemaValidation(t *testing.T) {
+	spec, err := ParseSpec([]byte(`
+openapi: 3.0.0
+paths: {}
+components:
+  schemas:
+    Thing:
+      type: object
+      additionalProperties: false
+      required: [name]
+      properties:
+        name: {type: string, pattern: "^[a-z]+$"}
+        count: {type: integer, minimum: 1}
+        kind: {type: string, enum: [a, b]}
+        tags: {type: array, items: {type: string}, maxItems: 2}
+        parent: {$ref: '#/components/schemas/Thing'}
+        note: {type: string, nullable: true}
+`))
+	require.NoError(t, err)
+	thing := &schema{Ref: "#/components/schemas/Thing"}
+	valid := map[string]interface{}{"name": "abc", "count": 2.0, "kind": "a", "tags": []interface{}{"x"}, "note": nil,
+		"parent": map[string]interface{}{"name": "p"}}
+	assert.Empty(t, spec.validate(valid, thing, "body"))
+
+	invalid := map[string]interface{}{"count": 1.5, "kind": "c", "tags": []interface{}{"x", "y", 3.0}, "extra": true,
+		"parent": map[string]interface{}{"name": "P"}}
+	assert.Equal(t, []string{
+		"body.name is required",
+		"body.count must be an integer",
+		"body.extra is not allowed",
+		"body.kind must be one of [a b]",
+		"body.parent.name must match ^[a-z]+$",
+		"body.tags must have at most 2 items",
+		"body.tags[2] must be a string",
+	}, spec.validate(invalid, thing, "body"))
+
+	// generated examples of recursive schemas terminate and conform
+	example := spec.example(thing)
+	assert.Empty(t, spec.validate(roundTrip(t, example), thing, "body"))
+}
+
+// successCode returns the status code of the success response of op
+func successCode(op *operation) string {
+	for _, code := range []string{"200", "201", "202", "204"} {
+		if _, ok := op.Responses[code]; ok {
+			return code
+		}
+	}
+	return "default"
+}
+
+// roundTrip returns v as decoded from its JSON encoding
+func roundTrip(t *testing.T, v interface{}) interface{} {
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	var decoded interface{}
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	return decoded
+}
+
+func strPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,463 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package mockserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"regexp/syntax"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+type schema struct {
+	Ref                  string             `json:"$ref"`
+	Type                 string             `json:"type"`
+	Format               string             `json:"format"`
+	Nullable             bool               `json:"nullable"`
+	Enum                 []interface{}      `json:"enum"`
+	Default              interface{}        `json:"default"`
+	Example              interface{}        `json:"example"`
+	Properties           map[string]*schema `json:"properties"`
+	Required             []string           `json:"required"`
+	AdditionalProperties json.RawMessage    `json:"additionalProperties"`
+	Items                *schema            `json:"items"`
+	AllOf                []*schema          `json:"allOf"`
+	OneOf                []*schema          `json:"oneOf"`
+	AnyOf                []*schema          `json:"anyOf"`
+	MinLength            *int               `json:"minLength"`
+	MaxLength            *int               `json:"maxLength"`
+	Pattern              string             `json:"pattern"`
+	Minimum              *float64           `json:"minimum"`
+	Maximum              *float64           `json:"maximum"`
+	MinItems             *int               `json:"minItems"`
+	MaxItems             *int               `json:"maxItems"`
+}
+
+// additionalProperties returns the schema of properties not listed in Properties, and false if they are not allowed
+func (s *Spec) additionalProperties(sc *schema) (*schema, bool) {
+	raw := strings.TrimSpace(string(sc.AdditionalProperties))
+	switch raw {
+	case "", "true":
+		return nil, true
+	case "false":
+		return nil, false
+	}
+	var additional schema
+	if err := json.Unmarshal(sc.AdditionalProperties, &additional); err != nil {
+		return nil, true
+	}
+	return &additional, true
+}
+
+// maxDepth bounds the nesting of validated and generated values, such that recursive schemas terminate
+const maxDepth = 32
+
+// validate returns the violations of sc by v, a value decoded from JSON, whose location is path
+func (s *Spec) validate(v interface{}, sc *schema, path string) []string {
+	return s.validateDepth(v, sc, path, 0)
+}
+
+func (s *Spec) validateDepth(v interface{}, sc *schema, path string, depth int) []string {
+	sc = s.schema(sc)
+	if sc == nil || depth > maxDepth {
+		return nil
+	}
+	var errs []string
+	for _, all := range sc.AllOf {
+		errs = append(errs, s.validateDepth(v, all, path, depth+1)...)
+	}
+	// oneOf is validated as anyOf since the specs do not use discriminators to tell overlapping schemas apart
+	for _, any := range [][]*schema{sc.OneOf, sc.AnyOf} {
+		if len(any) == 0 {
+			continue
+		}
+		matched := false
+		for _, alt := range any {
+			if len(s.validateDepth(v, alt, path, depth+1)) == 0 {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			errs = append(errs, fmt.Sprintf("%s does not match any of the allowed schemas", path))
+		}
+	}
+	if v == nil {
+		if !sc.Nullable && sc.Type != "" {
+			errs = append(errs, fmt.Sprintf("%s must not be null", path))
+		}
+		return errs
+	}
+	if len(sc.Enum) > 0 && !inEnum(v, sc.Enum) {
+		errs = append(errs, fmt.Sprintf("%s must be one of %v", path, sc.Enum))
+	}
+	switch sc.Type {
+	case "object":
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return append(errs, fmt.Sprintf("%s must be an object", path))
+		}
+		for _, name := range sc.Required {
+			if _, ok := obj[name]; !ok {
+				errs = append(errs, fmt.Sprintf("%s.%s is required", path, name))
+			}
+		}
+		additional, allowed := s.additionalProperties(sc)
+		known := s.properties(sc, depth)
+		for _, name := range sortedKeys(obj) {
+			if prop, ok := sc.Properties[name]; ok {
+				errs = append(errs, s.validateDepth(obj[name], prop, path+"."+name, depth+1)...)
+			} else if _, ok := known[name]; ok {
+				// validated by the allOf schema defining it
+				continue
+			} else if !allowed {
+				errs = append(errs, fmt.Sprintf("%s.%s is not allowed", path, name))
+			} else if additional != nil {
+				errs = append(errs, s.validateDepth(obj[name], additional, path+"."+name, depth+1)...)
+			}
+		}
+	case "array":
+		arr, ok := v.([]interface{})
+		if !ok {
+			return append(errs, fmt.Sprintf("%s must be an array", path))
+		}
+		if sc.MinItems != nil && len(arr) < *sc.MinItems {
+			errs = append(errs, fmt.Sprintf("%s must have at least %d items", path, *sc.MinItems))
+		}
+		if sc.MaxItems != nil && len(arr) > *sc.MaxItems {
+			errs = append(errs, fmt.Sprintf("%s must have at most %d items", path, *sc.MaxItems))
+		}
+		for i, item := range arr {
+			errs = append(errs, s.validateDepth(item, sc.Items, fmt.Sprintf("%s[%d]", path, i), depth+1)...)
+		}
+	case "string":
+		str, ok := v.(string)
+		if !ok {
+			return append(errs, fmt.Sprintf("%s must be a string", path))
+		}
+		length := utf8.RuneCountInString(str)
+		if sc.MinLength != nil && length < *sc.MinLength {
+			errs = append(errs, fmt.Sprintf("%s must be at least %d characters", path, *sc.MinLength))
+		}
+		if sc.MaxLength != nil && length > *sc.MaxLength {
+			errs = append(errs, fmt.Sprintf("%s must be at most %d characters", path, *sc.MaxLength))
+		}
+		if sc.Pattern != "" {
+			if re, err := regexp.Compile(sc.Pattern); err == nil && !re.MatchString(str) {
+				errs = append(errs, fmt.Sprintf("%s must match %s", path, sc.Pattern))
+			}
+		}
+	case "integer", "number":
+		n, ok := v.(float64)
+		if !ok {
+			return append(errs, fmt.Sprintf("%s must be a %s", path, sc.Type))
+		}
+		if sc.Type == "integer" && n != math.Trunc(n) {
+			errs = append(errs, fmt.Sprintf("%s must be an integer", path))
+		}
+		if sc.Minimum != nil && n < *sc.Minimum {
+			errs = append(errs, fmt.Sprintf("%s must be at least %v", path, *sc.Minimum))
+		}
+		if sc.Maximum != nil && n > *sc.Maximum {
+			errs = append(errs, fmt.Sprintf("%s must be at most %v", path, *sc.Maximum))
+		}
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			errs = append(errs, fmt.Sprintf("%s must be a boolean", path))
+		}
+	}
+	return errs
+}
+
+// parseParam converts the string value of a path, query or header parameter to the type of sc for validation,
+// returning the string itself if it cannot be converted so that validation reports the type mismatch
+func (s *Spec) parseParam(value string, sc *schema) interface{} {
+	sc = s.schema(sc)
+	if sc == nil {
+		return value
+	}
+	switch sc.Type {
+	case "integer", "number":
+		if n, err := strconv.ParseFloat(value, 64); err == nil {
+			return n
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	case "array":
+		var items []interface{}
+		for _, item := range strings.Split(value, ",") {
+			items = append(items, s.parseParam(item, sc.Items))
+		}
+		return items
+	}
+	return value
+}
+
+// example returns an example value conforming to sc, using the examples, defaults and enums of the spec if any
+func (s *Spec) example(sc *schema) interface{} {
+	return s.exampleDepth(sc, 0)
+}
+
+func (s *Spec) exampleDepth(sc *schema, depth int) interface{} {
+	sc = s.schema(sc)
+	if sc == nil {
+		return nil
+	}
+	// the examples and defaults of some specs do not conform to their schemas, e.g. "false" strings decoded as
+	// booleans from YAML, in which case an example is generated
+	for _, v := range append([]interface{}{sc.Example, sc.Default}, sc.Enum...) {
+		if v == nil {
+			continue
+		}
+		if _, ok := v.(string); !ok && sc.Type == "string" {
+			v = fmt.Sprint(v)
+		}
+		if len(s.validateDepth(v, sc, "", depth)) == 0 {
+			return v
+		}
+	}
+	if len(sc.AllOf) > 0 {
+		merged := map[string]interface{}{}
+		for _, all := range sc.AllOf {
+			if obj, ok := s.exampleDepth(all, depth+1).(map[string]interface{}); ok {
+				for k, v := range obj {
+					merged[k] = v
+				}
+			}
+		}
+		if obj, ok := s.exampleObject(sc, depth).(map[string]interface{}); ok {
+			for k, v := range obj {
+				merged[k] = v
+			}
+		}
+		return merged
+	}
+	if len(sc.OneOf) > 0 {
+		return s.exampleDepth(sc.OneOf[0], depth+1)
+	}
+	if len(sc.AnyOf) > 0 {
+		return s.exampleDepth(sc.AnyOf[0], depth+1)
+	}
+	switch sc.Type {
+	case "array":
+		if depth > maxDepth/4 {
+			return []interface{}{}
+		}
+		items := []interface{}{}
+		n := 1
+		if sc.MinItems != nil && *sc.MinItems > n {
+			n = *sc.MinItems
+		}
+		if sc.MaxItems != nil && *sc.MaxItems < n {
+			n = *sc.MaxItems
+		}
+		for i := 0; i < n; i++ {
+			items = append(items, s.exampleDepth(sc.Items, depth+1))
+		}
+		return items
+	case "string":
+		return s.exampleString(sc)
+	case "integer", "number":
+		if sc.Minimum != nil {
+			return *sc.Minimum
+		}
+		if sc.Maximum != nil && *sc.Maximum < 0 {
+			return *sc.Maximum
+		}
+		return 0
+	case "boolean":
+		return true
+	}
+	return s.exampleObject(sc, depth)
+}
+
+// exampleObject returns an example of the properties of sc, only the required properties are included once
+// nested deeply such that recursive schemas terminate
+func (s *Spec) exampleObject(sc *schema, depth int) interface{} {
+	if sc.Type != "object" && len(sc.Properties) == 0 {
+		return nil
+	}
+	obj := map[string]interface{}{}
+	for name, prop := range sc.Properties {
+		if depth > maxDepth/4 && !contains(sc.Required, name) {
+			continue
+		}
+		if depth > maxDepth {
+			break
+		}
+		obj[name] = s.exampleDepth(prop, depth+1)
+	}
+	// some specs require properties they do not define
+	known := s.properties(sc, 0)
+	additional, _ := s.additionalProperties(sc)
+	for _, name := range sc.Required {
+		if _, ok := known[name]; !ok {
+			if additional != nil {
+				obj[name] = s.exampleDepth(additional, depth+1)
+			} else {
+				obj[name] = "string"
+			}
+		}
+	}
+	return obj
+}
+
+// properties returns the properties of sc including those of the schemas it is composed of with allOf
+func (s *Spec) properties(sc *schema, depth int) map[string]*schema {
+	props := map[string]*schema{}
+	sc = s.schema(sc)
+	if sc == nil || depth > maxDepth {
+		return props
+	}
+	for _, all := range sc.AllOf {
+		for name, prop := range s.properties(all, depth+1) {
+			props[name] = prop
+		}
+	}
+	for name, prop := range sc.Properties {
+		props[name] = prop
+	}
+	return props
+}
+
+// exampleString returns an example string conforming to the format, pattern and length of sc
+func (s *Spec) exampleString(sc *schema) string {
+	if sc.Pattern != "" {
+		if str, ok := patternExample(sc.Pattern); ok && len(s.validate(str, sc, "")) == 0 {
+			return str
+		}
+	}
+	var str string
+	switch sc.Format {
+	case "date-time":
+		str = "2023-01-01T00:00:00Z"
+	case "date":
+		str = "2023-01-01"
+	case "uuid":
+		str = "00000000-0000-0000-0000-000000000000"
+	case "email":
+		str = "user@example.com"
+	case "uri", "url":
+		str = "https://example.com"
+	case "byte":
+		str = "ZXhhbXBsZQ=="
+	default:
+		str = "string"
+	}
+	if sc.MinLength != nil && len(str) < *sc.MinLength {
+		str += strings.Repeat("x", *sc.MinLength-len(str))
+	}
+	if sc.MaxLength != nil && len(str) > *sc.MaxLength {
+		str = str[:*sc.MaxLength]
+	}
+	return str
+}
+
+func inEnum(v interface{}, enum []interface{}) bool {
+	for _, e := range enum {
+		if reflect.DeepEqual(v, e) || fmt.Sprint(v) == fmt.Sprint(e) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// patternExample returns a short string matching pattern
+func patternExample(pattern string) (string, bool) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return "", false
+	}
+	var b strings.Builder
+	if !writeExample(&b, re.Simplify()) {
+		return "", false
+	}
+	return b.String(), true
+}
+
+// writeExample writes the shortest string matching re to b, preferring letters, and returns false if re has
+// operators without examples
+func writeExample(b *strings.Builder, re *syntax.Regexp) bool {
+	switch re.Op {
+	case syntax.OpEmptyMatch, syntax.OpBeginLine, syntax.OpEndLine, syntax.OpBeginText, syntax.OpEndText,
+		syntax.OpWordBoundary, syntax.OpNoWordBoundary, syntax.OpStar, syntax.OpQuest:
+		return true
+	case syntax.OpLiteral:
+		b.WriteString(string(re.Rune))
+		return true
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		b.WriteRune('a')
+		return true
+	case syntax.OpCharClass:
+		if len(re.Rune) < 2 {
+			return false
+		}
+		for i := 0; i+1 < len(re.Rune); i += 2 {
+			if re.Rune[i] <= 'a' && 'a' <= re.Rune[i+1] {
+				b.WriteRune('a')
+				return true
+			}
+		}
+		b.WriteRune(re.Rune[0])
+		return true
+	case syntax.OpCapture, syntax.OpPlus:
+		return writeExample(b, re.Sub[0])
+	case syntax.OpRepeat:
+		for i := 0; i < re.Min; i++ {
+			if !writeExample(b, re.Sub[0]) {
+				return false
+			}
+		}
+		return true
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			if !writeExample(b, sub) {
+				return false
+			}
+		}
+		return true
+	case syntax.OpAlternate:
+		return writeExample(b, re.Sub[0])
+	}
+	return false
+}
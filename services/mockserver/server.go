@@ -0,0 +1,420 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package mockserver serves the operations of the service specs with an httptest.Server, validating requests
+// against the specs and responding with examples generated from them, for contract level tests without Splunk
+// Cloud:
+//
+//	server, err := mockserver.NewServiceServer("identity", "search")
+//	...
+//	defer server.Close()
+//	client, err := sdk.NewClient(server.Config("mytenant"))
+package mockserver
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/splunk/splunk-cloud-sdk-go/services"
+)
+
+// Fixture is the response to an operation in place of the example generated from its spec
+type Fixture struct {
+	// StatusCode defaults to the success status of the operation
+	StatusCode int
+	Header     http.Header
+	// Body is written as is if a string or []byte and encoded as JSON otherwise
+	Body interface{}
+}
+
+// Server is an httptest.Server serving the operations of one or more specs. Requests are routed by method and
+// path, and their parameters and bodies are validated against the spec, invalid requests get a 400 response
+// and are reported by Violations. Valid requests get the response set with SetFixture or HandleFunc for the
+// operation, or else the spec's example of the success response, generated from its schema if the spec has none.
+//
+// Operations are identified by their operationId in the spec, e.g. "listJobs", optionally qualified with the
+// name of the spec, e.g. "search.listJobs", and matched case-insensitively such that the SDK method names,
+// e.g. "ListJobs", may be used.
+type Server struct {
+	*httptest.Server
+	routes     []*route
+	mux        sync.Mutex
+	fixtures   map[string]Fixture
+	handlers   map[string]http.HandlerFunc
+	served     []string
+	violations []error
+}
+
+// NewServer starts a Server for specs, which the caller should Close when finished
+func NewServer(specs ...*Spec) *Server {
+	s := &Server{
+		fixtures: map[string]Fixture{},
+		handlers: map[string]http.HandlerFunc{},
+	}
+	for _, spec := range specs {
+		s.routes = append(s.routes, spec.routes()...)
+	}
+	// prefer the most specific path across specs too
+	sort.SliceStable(s.routes, func(i, j int) bool { return s.routes[i].literals > s.routes[j].literals })
+	s.Server = httptest.NewServer(s)
+	return s
+}
+
+// NewServiceServer starts a Server for the specs of services, see ServiceSpec
+func NewServiceServer(services ...string) (*Server, error) {
+	var specs []*Spec
+	for _, service := range services {
+		spec, err := ServiceSpec(service)
+		if err != nil {
+			return nil, err
+		}
+		specs = append(specs, spec)
+	}
+	return NewServer(specs...), nil
+}
+
+// Config returns a services.Config for a client sending requests for tenant to the server
+func (s *Server) Config(tenant string) *services.Config {
+	u, _ := url.Parse(s.URL)
+	return &services.Config{
+		Token:        "mocktoken",
+		Tenant:       tenant,
+		OverrideHost: u.Host,
+		Scheme:       u.Scheme,
+	}
+}
+
+// SetFixture sets the response to operation
+func (s *Server) SetFixture(operation string, fixture Fixture) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.fixtures[strings.ToLower(operation)] = fixture
+}
+
+// HandleFunc sets the handler of valid requests to operation
+func (s *Server) HandleFunc(operation string, handler http.HandlerFunc) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.handlers[strings.ToLower(operation)] = handler
+}
+
+// Operations returns the operations served in order, qualified with the name of their spec, e.g. "search.listJobs"
+func (s *Server) Operations() []string {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return append([]string(nil), s.served...)
+}
+
+// Violations returns the errors of the requests which did not conform to the specs
+func (s *Server) Violations() []error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return append([]error(nil), s.violations...)
+}
+
+// Reset removes all fixtures and handlers and forgets served operations and violations
+func (s *Server) Reset() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.fixtures = map[string]Fixture{}
+	s.handlers = map[string]http.HandlerFunc{}
+	s.served = nil
+	s.violations = nil
+}
+
+// ServeHTTP routes, validates and responds to a request
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rt, params, allowed := s.route(r)
+	if rt == nil {
+		if allowed {
+			writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", fmt.Sprintf("%s %s is not allowed", r.Method, r.URL.Path), nil)
+			return
+		}
+		writeError(w, http.StatusNotFound, "route_not_found", fmt.Sprintf("no operation for %s %s", r.Method, r.URL.Path), nil)
+		return
+	}
+	name := rt.spec.Name + "." + rt.op.OperationID
+	if errs := rt.validate(r, params); len(errs) > 0 {
+		s.mux.Lock()
+		s.violations = append(s.violations, fmt.Errorf("%s: %s", name, strings.Join(errs, "; ")))
+		s.mux.Unlock()
+		writeError(w, http.StatusBadRequest, "invalid_request", strings.Join(errs, "; "), errs)
+		return
+	}
+
+	s.mux.Lock()
+	s.served = append(s.served, name)
+	handler, fixture, hasFixture := s.lookup(strings.ToLower(name), strings.ToLower(rt.op.OperationID))
+	s.mux.Unlock()
+	if handler != nil {
+		handler(w, r)
+		return
+	}
+	status, example := rt.example()
+	if hasFixture {
+		if fixture.StatusCode != 0 {
+			status = fixture.StatusCode
+		}
+		example = fixture.Body
+		for k, v := range fixture.Header {
+			w.Header()[k] = v
+		}
+	}
+	writeBody(w, status, example)
+}
+
+// lookup returns the handler or fixture for the first of keys set, s.mux must be held
+func (s *Server) lookup(keys ...string) (http.HandlerFunc, Fixture, bool) {
+	for _, key := range keys {
+		if handler, ok := s.handlers[key]; ok {
+			return handler, Fixture{}, false
+		}
+		if fixture, ok := s.fixtures[key]; ok {
+			return nil, fixture, true
+		}
+	}
+	return nil, Fixture{}, false
+}
+
+// route returns the route of r and its path parameters, or nil and whether the path is served with other methods
+func (s *Server) route(r *http.Request) (*route, map[string]string, bool) {
+	path := r.URL.EscapedPath()
+	allowed := false
+	for _, rt := range s.routes {
+		match := rt.pattern.FindStringSubmatch(path)
+		if match == nil {
+			continue
+		}
+		if rt.method != r.Method {
+			allowed = true
+			continue
+		}
+		params := map[string]string{}
+		for i, name := range rt.names {
+			value, err := url.PathUnescape(match[i+1])
+			if err != nil {
+				value = match[i+1]
+			}
+			params[name] = value
+		}
+		return rt, params, true
+	}
+	return nil, nil, allowed
+}
+
+// validate returns the violations of the route's parameters and request body by r
+func (rt *route) validate(r *http.Request, pathParams map[string]string) []string {
+	var errs []string
+	query := r.URL.Query()
+	for _, p := range rt.parameters {
+		var values []string
+		switch p.In {
+		case "path":
+			values = []string{pathParams[p.Name]}
+		case "query":
+			values = query[p.Name]
+		case "header":
+			values = r.Header.Values(p.Name)
+		default:
+			continue
+		}
+		if len(values) == 0 || len(values) == 1 && values[0] == "" && p.In != "path" {
+			if p.Required {
+				errs = append(errs, fmt.Sprintf("%s parameter %s is required", p.In, p.Name))
+			}
+			continue
+		}
+		location := fmt.Sprintf("%s parameter %s", p.In, p.Name)
+		if sc := rt.spec.schema(p.Schema); sc != nil && sc.Type == "array" && len(values) > 1 {
+			// exploded array, e.g. ?include=a&include=b
+			items := []interface{}{}
+			for _, v := range values {
+				items = append(items, rt.spec.parseParam(v, sc.Items))
+			}
+			errs = append(errs, rt.spec.validate(items, sc, location)...)
+			continue
+		}
+		errs = append(errs, rt.spec.validate(rt.spec.parseParam(values[0], p.Schema), p.Schema, location)...)
+	}
+	return append(errs, rt.validateBody(r)...)
+}
+
+// validateBody returns the violations of the route's request body by the body of r
+func (rt *route) validateBody(r *http.Request) []string {
+	body := rt.spec.requestBody(rt.op.RequestBody)
+	if body == nil {
+		return nil
+	}
+	data, err := readBody(r)
+	if err != nil {
+		return []string{fmt.Sprintf("reading request body: %v", err)}
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		if body.Required {
+			return []string{"request body is required"}
+		}
+		return nil
+	}
+	contentType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	media, ok := body.Content[contentType]
+	if !ok {
+		if contentType != "" && len(body.Content) > 0 && !isJSON(contentType) {
+			return []string{fmt.Sprintf("content type %s is not accepted", contentType)}
+		}
+		// the SDK sends JSON bodies for application/json and vendor JSON types alike
+		for ct, m := range body.Content {
+			if isJSON(ct) {
+				media, contentType = m, ct
+				break
+			}
+		}
+	}
+	if media == nil || !isJSON(contentType) {
+		return nil
+	}
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return []string{fmt.Sprintf("request body is not valid JSON: %v", err)}
+	}
+	return rt.spec.validate(v, media.Schema, "body")
+}
+
+// readBody reads the body of r, decompressing gzipped bodies
+func readBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil {
+		return nil, nil
+	}
+	var reader io.Reader = r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		reader = gz
+	}
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
+
+// example returns the success status of the route's operation and an example of its response body, which is
+// nil for responses without content
+func (rt *route) example() (int, interface{}) {
+	status, resp := http.StatusOK, (*response)(nil)
+	var codes []string
+	for code := range rt.op.Responses {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		if n, err := strconv.Atoi(code); err == nil && n >= 200 && n < 300 {
+			status, resp = n, rt.op.Responses[code]
+			break
+		}
+	}
+	if resp == nil {
+		resp = rt.op.Responses["default"]
+	}
+	resp = rt.spec.response(resp)
+	if resp == nil || len(resp.Content) == 0 {
+		return status, nil
+	}
+	var media *mediaType
+	var contentTypes []string
+	for ct := range resp.Content {
+		contentTypes = append(contentTypes, ct)
+	}
+	sort.Strings(contentTypes)
+	for _, ct := range contentTypes {
+		if media == nil || isJSON(ct) {
+			media = resp.Content[ct]
+		}
+	}
+	if media.Example != nil {
+		return status, media.Example
+	}
+	return status, rt.spec.example(media.Schema)
+}
+
+// writeBody writes a response with status and body, encoded as JSON unless a string or []byte
+func writeBody(w http.ResponseWriter, status int, body interface{}) {
+	var data []byte
+	switch b := body.(type) {
+	case nil:
+	case []byte:
+		data = b
+	case string:
+		data = []byte(b)
+	default:
+		var err error
+		if data, err = json.Marshal(b); err != nil {
+			writeError(w, http.StatusInternalServerError, "invalid_fixture", err.Error(), nil)
+			return
+		}
+		if w.Header().Get("Content-Type") == "" {
+			w.Header().Set("Content-Type", "application/json")
+		}
+	}
+	w.WriteHeader(status)
+	_, _ = w.Write(data)
+}
+
+// writeError writes an error response in the format of the services, see util.HTTPError
+func writeError(w http.ResponseWriter, status int, code string, message string, details []string) {
+	body := map[string]interface{}{"code": code, "message": message}
+	if len(details) > 0 {
+		body["details"] = details
+	}
+	w.Header().Set("Content-Type", "application/json")
+	writeBody(w, status, body)
+}
+
+func isJSON(contentType string) bool {
+	return contentType == "application/json" || strings.HasSuffix(contentType, "+json")
+}
+
+// ErrViolations is returned by Check if any requests did not conform to the specs
+var ErrViolations = errors.New("mockserver: requests did not conform to the specs")
+
+// Check returns an error wrapping ErrViolations and listing the violations, if any
+func (s *Server) Check() error {
+	violations := s.Violations()
+	if len(violations) == 0 {
+		return nil
+	}
+	msgs := make([]string, len(violations))
+	for i, v := range violations {
+		msgs[i] = v.Error()
+	}
+	return fmt.Errorf("%w:\n%s", ErrViolations, strings.Join(msgs, "\n"))
+}
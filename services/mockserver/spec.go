@@ -0,0 +1,270 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package mockserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Spec is a parsed OpenAPI 3 service specification
+type Spec struct {
+	// Name qualifies the operation IDs of the spec in fixture keys, by default the name of the spec file
+	// without extension
+	Name string
+	doc  document
+}
+
+type document struct {
+	Paths      map[string]*pathItem `json:"paths"`
+	Components struct {
+		Schemas       map[string]*schema      `json:"schemas"`
+		Parameters    map[string]*parameter   `json:"parameters"`
+		RequestBodies map[string]*requestBody `json:"requestBodies"`
+		Responses     map[string]*response    `json:"responses"`
+	} `json:"components"`
+}
+
+type pathItem struct {
+	Parameters []*parameter `json:"parameters"`
+	Get        *operation   `json:"get"`
+	Put        *operation   `json:"put"`
+	Post       *operation   `json:"post"`
+	Delete     *operation   `json:"delete"`
+	Patch      *operation   `json:"patch"`
+	Head       *operation   `json:"head"`
+}
+
+type operation struct {
+	OperationID string               `json:"operationId"`
+	Parameters  []*parameter         `json:"parameters"`
+	RequestBody *requestBody         `json:"requestBody"`
+	Responses   map[string]*response `json:"responses"`
+}
+
+type parameter struct {
+	Ref      string  `json:"$ref"`
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *schema `json:"schema"`
+}
+
+type requestBody struct {
+	Ref      string                `json:"$ref"`
+	Required bool                  `json:"required"`
+	Content  map[string]*mediaType `json:"content"`
+}
+
+type response struct {
+	Ref     string                `json:"$ref"`
+	Content map[string]*mediaType `json:"content"`
+}
+
+type mediaType struct {
+	Schema  *schema     `json:"schema"`
+	Example interface{} `json:"example"`
+}
+
+// ServiceSpec loads the spec checked in next to the package of service, e.g. "search" or "appregistry"
+func ServiceSpec(service string) (*Spec, error) {
+	_, filename, _, _ := runtime.Caller(0)
+	files, err := filepath.Glob(filepath.Join(filepath.Dir(filename), "..", service, "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) != 1 {
+		return nil, fmt.Errorf("mockserver: no spec found for service %q", service)
+	}
+	spec, err := LoadSpec(files[0])
+	if err != nil {
+		return nil, err
+	}
+	spec.Name = service
+	return spec, nil
+}
+
+// LoadSpec loads an OpenAPI 3 spec from a YAML or JSON file
+func LoadSpec(path string) (*Spec, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := ParseSpec(data)
+	if err != nil {
+		return nil, fmt.Errorf("mockserver: %s: %v", path, err)
+	}
+	spec.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	return spec, nil
+}
+
+// ParseSpec parses an OpenAPI 3 spec in YAML or JSON
+func ParseSpec(data []byte) (*Spec, error) {
+	var doc interface{}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	// YAML maps have interface{} keys which cannot be marshaled to JSON
+	data, err := json.Marshal(stringKeys(doc))
+	if err != nil {
+		return nil, err
+	}
+	var spec Spec
+	if err := json.Unmarshal(data, &spec.doc); err != nil {
+		return nil, err
+	}
+	return &spec, nil
+}
+
+// stringKeys converts the maps decoded from YAML to maps with string keys
+func stringKeys(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			m[fmt.Sprint(key)] = stringKeys(value)
+		}
+		return m
+	case []interface{}:
+		for i, value := range v {
+			v[i] = stringKeys(value)
+		}
+	}
+	return v
+}
+
+// route is an operation of a spec and the pattern matching its path
+type route struct {
+	spec       *Spec
+	method     string
+	path       string
+	pattern    *regexp.Regexp
+	names      []string
+	literals   int
+	op         *operation
+	parameters []*parameter
+}
+
+var pathParam = regexp.MustCompile(`\{[^}]+\}`)
+
+// routes returns the operations of the spec
+func (s *Spec) routes() []*route {
+	var routes []*route
+	for path, item := range s.doc.Paths {
+		var names []string
+		expr, last := "^", 0
+		for _, loc := range pathParam.FindAllStringIndex(path, -1) {
+			names = append(names, path[loc[0]+1:loc[1]-1])
+			expr += regexp.QuoteMeta(path[last:loc[0]]) + "([^/]+)"
+			last = loc[1]
+		}
+		pattern := regexp.MustCompile(expr + regexp.QuoteMeta(path[last:]) + "/?$")
+		literals := len(pathParam.ReplaceAllString(path, ""))
+		for method, op := range map[string]*operation{
+			http.MethodGet: item.Get, http.MethodPut: item.Put, http.MethodPost: item.Post,
+			http.MethodDelete: item.Delete, http.MethodPatch: item.Patch, http.MethodHead: item.Head,
+		} {
+			if op == nil {
+				continue
+			}
+			routes = append(routes, &route{
+				spec:       s,
+				method:     method,
+				path:       path,
+				pattern:    pattern,
+				names:      names,
+				literals:   literals,
+				op:         op,
+				parameters: s.parameters(item.Parameters, op.Parameters),
+			})
+		}
+	}
+	// prefer the most specific path, e.g. /jobs/summary over /jobs/{sid}
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].literals != routes[j].literals {
+			return routes[i].literals > routes[j].literals
+		}
+		return routes[i].path < routes[j].path
+	})
+	return routes
+}
+
+// parameters resolves the parameters of an operation, which override those of its path with the same name
+func (s *Spec) parameters(pathParams []*parameter, opParams []*parameter) []*parameter {
+	var params []*parameter
+	index := map[string]int{}
+	for _, p := range append(append([]*parameter{}, pathParams...), opParams...) {
+		p = s.parameter(p)
+		if p == nil {
+			continue
+		}
+		key := p.In + ":" + p.Name
+		if i, ok := index[key]; ok {
+			params[i] = p
+			continue
+		}
+		index[key] = len(params)
+		params = append(params, p)
+	}
+	return params
+}
+
+func (s *Spec) parameter(p *parameter) *parameter {
+	for i := 0; p != nil && p.Ref != "" && i < maxRefs; i++ {
+		p = s.doc.Components.Parameters[refName(p.Ref)]
+	}
+	return p
+}
+
+func (s *Spec) requestBody(b *requestBody) *requestBody {
+	for i := 0; b != nil && b.Ref != "" && i < maxRefs; i++ {
+		b = s.doc.Components.RequestBodies[refName(b.Ref)]
+	}
+	return b
+}
+
+func (s *Spec) response(r *response) *response {
+	for i := 0; r != nil && r.Ref != "" && i < maxRefs; i++ {
+		r = s.doc.Components.Responses[refName(r.Ref)]
+	}
+	return r
+}
+
+func (s *Spec) schema(sc *schema) *schema {
+	for i := 0; sc != nil && sc.Ref != "" && i < maxRefs; i++ {
+		sc = s.doc.Components.Schemas[refName(sc.Ref)]
+	}
+	return sc
+}
+
+// maxRefs bounds the resolution of chained references
+const maxRefs = 10
+
+// refName returns the name of the component a local reference refers to, e.g. "SearchJob" for
+// "#/components/schemas/SearchJob"
+func refName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
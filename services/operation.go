@@ -0,0 +1,133 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package services
+
+import (
+	"strings"
+	"sync"
+)
+
+const systemNamespace = "system"
+
+// Operation describes a Splunk Cloud service endpoint
+type Operation struct {
+	// Service is the name of the service as it appears in request paths, e.g. "search" or "app-registry"
+	Service string
+	// Name is the name of the service method used to call the endpoint, e.g. "CreateJob"
+	Name string
+	// Method is the HTTP method of the endpoint
+	Method string
+	// PathTemplate is the path template of the endpoint, e.g. `/search/v2/jobs/{{.Sid}}`. Paths outside of
+	// the system namespace are relative to the tenant.
+	PathTemplate string
+}
+
+// operationRegistry holds the operations registered by each service package, keyed by HTTP method
+var operationRegistry = struct {
+	sync.RWMutex
+	ops map[string][]registeredOperation
+}{ops: make(map[string][]registeredOperation)}
+
+type registeredOperation struct {
+	Operation
+	segments []string
+	literals int
+}
+
+// RegisterOperations registers service endpoints so that requests can be matched to the operation
+// which made them, this is called when each service package is initialized
+func RegisterOperations(ops ...Operation) {
+	operationRegistry.Lock()
+	defer operationRegistry.Unlock()
+	for _, op := range ops {
+		segments := splitPath(op.PathTemplate)
+		literals := 0
+		for _, seg := range segments {
+			if !strings.HasPrefix(seg, "{{") {
+				literals++
+			}
+		}
+		operationRegistry.ops[op.Method] = append(operationRegistry.ops[op.Method], registeredOperation{
+			Operation: op,
+			segments:  segments,
+			literals:  literals,
+		})
+	}
+}
+
+// MatchOperation returns the operation registered for the given HTTP method and request URL path
+// (including the tenant), if no operation is registered then only the Service and Method of the
+// returned Operation are populated (when they can be determined from the path) and false is returned.
+// When several templates match, e.g. `/search/v2/jobs/delete` and `/search/v2/jobs/{{.Sid}}`, the
+// template with the most literal segments is used.
+func MatchOperation(method string, urlPath string) (Operation, bool) {
+	segments := splitPath(urlPath)
+	operationRegistry.RLock()
+	defer operationRegistry.RUnlock()
+	var match *registeredOperation
+	for i, op := range operationRegistry.ops[method] {
+		if (match == nil || op.literals > match.literals) && matchSegments(op.segments, segments) {
+			match = &operationRegistry.ops[method][i]
+		}
+	}
+	if match == nil {
+		return Operation{Service: serviceFromSegments(segments), Method: method}, false
+	}
+	return match.Operation, true
+}
+
+// matchSegments compares template segments to the request path segments, system namespace templates
+// match the full path while all other templates match the path following the tenant
+func matchSegments(template []string, path []string) bool {
+	if len(template) == 0 || template[0] != systemNamespace {
+		if len(path) == 0 {
+			return false
+		}
+		path = path[1:]
+	}
+	if len(template) != len(path) {
+		return false
+	}
+	for i, seg := range template {
+		if strings.HasPrefix(seg, "{{") {
+			if path[i] == "" {
+				return false
+			}
+			continue
+		}
+		if seg != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// serviceFromSegments returns the service name from the path segments, e.g. "search" from /mytenant/search/v2/jobs
+func serviceFromSegments(segments []string) string {
+	if len(segments) < 2 {
+		return ""
+	}
+	return segments[1]
+}
+
+func splitPath(p string) []string {
+	p = strings.Trim(p, "/")
+	if p == "" {
+		return nil
+	}
+	return strings.Split(p, "/")
+}
@@ -0,0 +1,79 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package services
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	RegisterOperations(
+		Operation{Service: "widgets", Name: "GetWidget", Method: http.MethodGet, PathTemplate: `/widgets/v1/widgets/{{.WidgetID}}`},
+		Operation{Service: "widgets", Name: "ListWidgets", Method: http.MethodGet, PathTemplate: `/widgets/v1/widgets`},
+		Operation{Service: "widgets", Name: "GetWidgetDefaults", Method: http.MethodGet, PathTemplate: `/widgets/v1/widgets/defaults`},
+		Operation{Service: "widgets", Name: "CreateWidget", Method: http.MethodPost, PathTemplate: `/widgets/v1/widgets`},
+		Operation{Service: "widgets", Name: "ListSystemWidgets", Method: http.MethodGet, PathTemplate: `/system/widgets/v1/widgets`},
+	)
+}
+
+func TestMatchOperation(t *testing.T) {
+	op, ok := MatchOperation(http.MethodGet, "/mytenant/widgets/v1/widgets/1234")
+	assert.True(t, ok)
+	assert.Equal(t, "GetWidget", op.Name)
+	assert.Equal(t, "widgets", op.Service)
+
+	op, ok = MatchOperation(http.MethodGet, "/mytenant/widgets/v1/widgets")
+	assert.True(t, ok)
+	assert.Equal(t, "ListWidgets", op.Name)
+
+	op, ok = MatchOperation(http.MethodPost, "/mytenant/widgets/v1/widgets")
+	assert.True(t, ok)
+	assert.Equal(t, "CreateWidget", op.Name)
+
+	// Literal segments are preferred over templated ones
+	op, ok = MatchOperation(http.MethodGet, "/mytenant/widgets/v1/widgets/defaults")
+	assert.True(t, ok)
+	assert.Equal(t, "GetWidgetDefaults", op.Name)
+
+	op, ok = MatchOperation(http.MethodGet, "/system/widgets/v1/widgets")
+	assert.True(t, ok)
+	assert.Equal(t, "ListSystemWidgets", op.Name)
+
+	// The system tenant may also call tenant endpoints
+	op, ok = MatchOperation(http.MethodGet, "/system/widgets/v1/widgets/1234")
+	assert.True(t, ok)
+	assert.Equal(t, "GetWidget", op.Name)
+}
+
+func TestMatchOperationUnregistered(t *testing.T) {
+	op, ok := MatchOperation(http.MethodDelete, "/mytenant/widgets/v1/widgets/1234")
+	assert.False(t, ok)
+	assert.Equal(t, "", op.Name)
+	assert.Equal(t, "widgets", op.Service)
+	assert.Equal(t, http.MethodDelete, op.Method)
+
+	op, ok = MatchOperation(http.MethodGet, "/system/sprockets/v1/sprockets")
+	assert.False(t, ok)
+	assert.Equal(t, "sprockets", op.Service)
+
+	op, ok = MatchOperation(http.MethodGet, "")
+	assert.False(t, ok)
+	assert.Equal(t, "", op.Service)
+}
@@ -19,7 +19,10 @@
 package provisioner
 
 import (
+	"context"
 	"net/http"
+
+	sdkutil "github.com/splunk/splunk-cloud-sdk-go/util"
 )
 
 // ServicerGenerated represents the interface for implementing all endpoints for this service
@@ -79,4 +82,78 @@ type ServicerGenerated interface {
 			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
 	*/
 	UpdateInvite(inviteId string, updateInviteBody UpdateInviteBody, resp ...*http.Response) (*InviteInfo, error)
+	/*
+		CreateInviteWithContext - provisioner service endpoint
+		Creates an invitation for a person to join the tenant using their email address.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			inviteBody
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	CreateInviteWithContext(ctx context.Context, inviteBody InviteBody, resp ...*http.Response) (*InviteInfo, error)
+	/*
+		DeleteInviteWithContext - provisioner service endpoint
+		Removes an invitation in the given tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			inviteId
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	DeleteInviteWithContext(ctx context.Context, inviteId string, resp ...*http.Response) error
+	/*
+		GetInviteWithContext - provisioner service endpoint
+		Returns an invitation in the given tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			inviteId
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetInviteWithContext(ctx context.Context, inviteId string, resp ...*http.Response) (*InviteInfo, error)
+	/*
+		GetTenantWithContext - provisioner service endpoint
+		Returns a specific tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			tenantName
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetTenantWithContext(ctx context.Context, tenantName string, resp ...*http.Response) (*TenantInfo, error)
+	/*
+		ListInvitesWithContext - provisioner service endpoint
+		Returns a list of invitations in a given tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListInvitesWithContext(ctx context.Context, resp ...*http.Response) (*Invites, error)
+	/*
+		ListTenantsWithContext - provisioner service endpoint
+		Returns all tenants that the user can read.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListTenantsWithContext(ctx context.Context, resp ...*http.Response) (*Tenants, error)
+	/*
+		UpdateInviteWithContext - provisioner service endpoint
+		Modifies an invitation in the given tenant.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			inviteId
+			updateInviteBody
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	UpdateInviteWithContext(ctx context.Context, inviteId string, updateInviteBody UpdateInviteBody, resp ...*http.Response) (*InviteInfo, error)
+	// ListInvitesPages returns a Pager over the pages of ListInvites, which returns a single page
+	ListInvitesPages() *sdkutil.Pager[InviteInfo]
+	// ListInvitesAll returns the items of all pages of ListInvites
+	ListInvitesAll() ([]InviteInfo, error)
+	// ListInvitesAllWithContext - ListInvitesAll bound to ctx
+	ListInvitesAllWithContext(ctx context.Context) ([]InviteInfo, error)
+	// ListTenantsPages returns a Pager over the pages of ListTenants, which returns a single page
+	ListTenantsPages() *sdkutil.Pager[TenantInfo]
+	// ListTenantsAll returns the items of all pages of ListTenants
+	ListTenantsAll() ([]TenantInfo, error)
+	// ListTenantsAllWithContext - ListTenantsAll bound to ctx
+	ListTenantsAllWithContext(ctx context.Context) ([]TenantInfo, error)
 }
@@ -0,0 +1,237 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package services
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// on a 429 response the rate is halved, but never below 1/minRateDivisor of the configured rate
+	minRateDivisor = 16
+	// each other response recovers 1/rateRecoveryDivisor of the configured rate
+	rateRecoveryDivisor = 20
+)
+
+// RateLimit configures a token bucket which allows Rate requests per second on average with bursts of up to
+// Burst requests
+type RateLimit struct {
+	// Rate is the number of requests allowed per second
+	Rate float64
+	// Burst is the (optional) number of requests which may be sent at once, default to 1
+	Burst int
+}
+
+// RateLimitConfig configures client-side rate limiting, requests wait (until their context is done) before
+// being sent until both the limit for their service and the limit for their tenant allow them
+type RateLimitConfig struct {
+	// Services are the limits for requests to each service keyed by the service name as it appears in the
+	// request path, e.g. "ingest" or "kvstore"
+	Services map[string]RateLimit
+	// Tenants are the limits for requests to each tenant keyed by tenant name
+	Tenants map[string]RateLimit
+	// Adaptive if true halves the rate of a limit each time a 429 Too Many Requests response is received for
+	// a request it applies to, then gradually recovers the configured rate as successful (2xx and 3xx)
+	// responses are received
+	Adaptive bool
+}
+
+// tokenBucket is a token bucket rate limiter whose rate can be lowered below its configured rate
+type tokenBucket struct {
+	mux     sync.Mutex
+	limit   RateLimit
+	rate    float64
+	tokens  float64
+	updated time.Time
+	now     func() time.Time
+}
+
+func newTokenBucket(limit RateLimit) *tokenBucket {
+	if limit.Burst <= 0 {
+		limit.Burst = 1
+	}
+	return &tokenBucket{
+		limit:   limit,
+		rate:    limit.Rate,
+		tokens:  float64(limit.Burst),
+		updated: time.Now(),
+		now:     time.Now,
+	}
+}
+
+// refill adds the tokens accrued since the bucket was last updated, b.mux must be held
+func (b *tokenBucket) refill() {
+	now := b.now()
+	b.tokens += now.Sub(b.updated).Seconds() * b.rate
+	if burst := float64(b.limit.Burst); b.tokens > burst {
+		b.tokens = burst
+	}
+	b.updated = now
+}
+
+// wait blocks until a token is available or ctx is done
+func (b *tokenBucket) wait(ctx context.Context) error {
+	for {
+		b.mux.Lock()
+		b.refill()
+		if b.tokens >= 1 {
+			b.tokens--
+			b.mux.Unlock()
+			return nil
+		}
+		delay := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+		b.mux.Unlock()
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// release gives back a token taken by wait for a request which is not sent
+func (b *tokenBucket) release() {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	b.refill()
+	b.tokens++
+	if burst := float64(b.limit.Burst); b.tokens > burst {
+		b.tokens = burst
+	}
+}
+
+// throttled halves the rate of the bucket
+func (b *tokenBucket) throttled() {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	b.refill()
+	b.rate /= 2
+	if min := b.limit.Rate / minRateDivisor; b.rate < min {
+		b.rate = min
+	}
+}
+
+// succeeded recovers some of the configured rate of the bucket
+func (b *tokenBucket) succeeded() {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	if b.rate >= b.limit.Rate {
+		return
+	}
+	b.refill()
+	b.rate += b.limit.Rate / rateRecoveryDivisor
+	if b.rate > b.limit.Rate {
+		b.rate = b.limit.Rate
+	}
+}
+
+// rateLimiter is a RequestHandler which waits for the service and tenant limits of each request, a
+// RequestVetoHandler which gives back the tokens of vetoed requests and a ResponseHandler which adapts the
+// limits to 429 responses
+type rateLimiter struct {
+	services map[string]*tokenBucket
+	tenants  map[string]*tokenBucket
+	adaptive bool
+}
+
+// newRateLimiter returns nil if no limits are configured
+func newRateLimiter(config RateLimitConfig) *rateLimiter {
+	if len(config.Services) == 0 && len(config.Tenants) == 0 {
+		return nil
+	}
+	rl := &rateLimiter{
+		services: make(map[string]*tokenBucket),
+		tenants:  make(map[string]*tokenBucket),
+		adaptive: config.Adaptive,
+	}
+	for service, limit := range config.Services {
+		if limit.Rate > 0 {
+			rl.services[service] = newTokenBucket(limit)
+		}
+	}
+	for tenant, limit := range config.Tenants {
+		if limit.Rate > 0 {
+			rl.tenants[tenant] = newTokenBucket(limit)
+		}
+	}
+	return rl
+}
+
+// buckets returns the buckets limiting the request
+func (rl *rateLimiter) buckets(request *Request) []*tokenBucket {
+	var buckets []*tokenBucket
+	if b, ok := rl.services[request.Operation.Service]; ok {
+		buckets = append(buckets, b)
+	}
+	if b, ok := rl.tenants[tenantFromPath(request.URL.Path)]; ok {
+		buckets = append(buckets, b)
+	}
+	return buckets
+}
+
+// HandleRequest waits until the request is allowed by its limits, giving back the tokens already taken if
+// the context is done while waiting for a later limit
+func (rl *rateLimiter) HandleRequest(client *BaseClient, request *Request) error {
+	buckets := rl.buckets(request)
+	for i, b := range buckets {
+		if err := b.wait(request.Context()); err != nil {
+			for _, taken := range buckets[:i] {
+				taken.release()
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// HandleRequestVeto gives back the tokens taken for a request vetoed by a later RequestHandler
+func (rl *rateLimiter) HandleRequestVeto(client *BaseClient, request *Request, err error) {
+	for _, b := range rl.buckets(request) {
+		b.release()
+	}
+}
+
+// HandleResponse adapts the limits of the request to the response, if enabled
+func (rl *rateLimiter) HandleResponse(client *BaseClient, request *Request, response *http.Response) (*http.Response, error) {
+	if !rl.adaptive {
+		return response, nil
+	}
+	for _, b := range rl.buckets(request) {
+		// other errors, such as 5xx responses from a failing service, leave the rate unchanged
+		switch {
+		case response.StatusCode == http.StatusTooManyRequests:
+			b.throttled()
+		case response.StatusCode < 400:
+			b.succeeded()
+		}
+	}
+	return response, nil
+}
+
+// tenantFromPath returns the tenant from a request path, e.g. "mytenant" from /mytenant/search/v2/jobs
+func tenantFromPath(urlPath string) string {
+	segments := splitPath(urlPath)
+	if len(segments) == 0 || segments[0] == systemNamespace {
+		return ""
+	}
+	return segments[0]
+}
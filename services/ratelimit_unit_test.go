@@ -0,0 +1,149 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/splunk/go-dependencies/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+	b := newTokenBucket(RateLimit{Rate: 10, Burst: 2})
+	b.now = func() time.Time { return now }
+	b.updated = now
+	ctx := context.Background()
+	require.NoError(t, b.wait(ctx))
+	require.NoError(t, b.wait(ctx))
+	assert.True(t, b.tokens < 1, "burst should be used up")
+	now = now.Add(100 * time.Millisecond)
+	require.NoError(t, b.wait(ctx), "a token should accrue after 1/rate seconds")
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.Equal(t, context.Canceled, b.wait(canceled))
+}
+
+func TestTokenBucketAdaptive(t *testing.T) {
+	b := newTokenBucket(RateLimit{Rate: 16})
+	b.throttled()
+	assert.Equal(t, 8.0, b.rate)
+	for i := 0; i < 10; i++ {
+		b.throttled()
+	}
+	assert.Equal(t, 1.0, b.rate, "rate should not be lowered below 1/16 of the configured rate")
+	for i := 0; i < 30; i++ {
+		b.succeeded()
+	}
+	assert.Equal(t, 16.0, b.rate, "rate should recover to the configured rate")
+}
+
+func TestRateLimiterAdaptsToResponses(t *testing.T) {
+	rl := newRateLimiter(RateLimitConfig{Services: map[string]RateLimit{"widgets": {Rate: 16}}, Adaptive: true})
+	b := rl.services["widgets"]
+	request := &Request{Request: &http.Request{URL: &url.URL{Path: "/mytenant/widgets/v1/widgets"}}, Operation: Operation{Service: "widgets"}}
+	respond := func(status int) {
+		_, err := rl.HandleResponse(nil, request, &http.Response{StatusCode: status})
+		require.NoError(t, err)
+	}
+	respond(http.StatusTooManyRequests)
+	assert.Equal(t, 8.0, b.rate)
+	// the rate does not recover while the service is failing
+	for _, status := range []int{http.StatusServiceUnavailable, http.StatusInternalServerError, http.StatusBadRequest} {
+		respond(status)
+		assert.Equal(t, 8.0, b.rate, "status %d", status)
+	}
+	respond(http.StatusOK)
+	assert.Equal(t, 8.8, b.rate)
+	respond(http.StatusNotModified)
+	assert.InDelta(t, 9.6, b.rate, 1e-9)
+}
+
+func TestRateLimiterReleasesTokens(t *testing.T) {
+	rl := newRateLimiter(RateLimitConfig{
+		Services: map[string]RateLimit{"widgets": {Rate: 0.001}},
+		Tenants:  map[string]RateLimit{"mytenant": {Rate: 0.001}},
+	})
+	service, tenant := rl.services["widgets"], rl.tenants["mytenant"]
+	ctx, cancel := context.WithCancel(context.Background())
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.example.com/mytenant/widgets/v1/widgets", nil)
+	require.NoError(t, err)
+	request := &Request{Request: httpRequest, Operation: Operation{Service: "widgets"}}
+
+	// a request vetoed by a later handler gives back its tokens
+	require.NoError(t, rl.HandleRequest(nil, request))
+	rl.HandleRequestVeto(nil, request, errors.New("vetoed"))
+	assert.True(t, service.tokens >= 1)
+	assert.True(t, tenant.tokens >= 1)
+
+	// the service token is given back if the context is done while waiting for the tenant limit
+	tenant.tokens = 0
+	cancel()
+	assert.Equal(t, context.Canceled, rl.HandleRequest(nil, request))
+	assert.True(t, service.tokens >= 1, "the service token should have been given back")
+}
+
+func TestClientRateLimits(t *testing.T) {
+	rt := &statusRT{Statuses: []int{429}}
+	client, err := NewClient(&Config{
+		Token:  "testtoken",
+		Tenant: "mytenant",
+		RateLimits: RateLimitConfig{
+			Services: map[string]RateLimit{"widgets": {Rate: 50}},
+			Tenants:  map[string]RateLimit{"othertenant": {Rate: 0.001}},
+			Adaptive: true,
+		},
+		RetryRequests: true,
+		RetryConfig: RetryStrategyConfig{
+			ConfigurableRetryConfig: &ConfigurableRetryConfig{
+				RetryNum: 2,
+				Interval: 1,
+			},
+		},
+		RoundTripper: rt,
+	})
+	require.Nil(t, err, "Error calling NewClient(): %s", err)
+	u, err := client.BuildURLFromPathParams(nil, "api", `/widgets/v1/widgets`, nil)
+	require.NoError(t, err)
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		_, err = client.Get(services.RequestParams{URL: u})
+		require.NoError(t, err)
+	}
+	// four attempts, the first using the initial token then three at 50 and (once throttled) 25 requests per second
+	assert.True(t, time.Since(start) >= 60*time.Millisecond, "requests should have been limited, took %s", time.Since(start))
+	assert.Equal(t, 4, rt.N)
+
+	// requests to other tenants wait on their limit until their context is done
+	u, err = client.BuildURLWithTenant("othertenant", false, "", nil, "api", "other")
+	require.NoError(t, err)
+	_, err = client.Get(services.RequestParams{URL: u})
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = client.WithContext(ctx).Get(services.RequestParams{URL: u})
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, 5, rt.N)
+}
@@ -19,7 +19,10 @@
 package search
 
 import (
+	"context"
 	"time"
+
+	"github.com/splunk/splunk-cloud-sdk-go/util"
 )
 
 // Servicer represents the interface for implementing all endpoints for this service
@@ -27,6 +30,12 @@ type Servicer interface {
 	//interfaces that cannot be auto-generated from codegen
 	// WaitForJob polls the job until it's completed or errors out
 	WaitForJob(jobID string, pollInterval time.Duration) (interface{}, error)
+	// WaitForJobWithContext polls the job until it's completed, errors out or ctx is done
+	WaitForJobWithContext(ctx context.Context, jobID string, pollInterval time.Duration) (interface{}, error)
+	// ExportResultsStream exports the search results for the job as an iterator which decodes results as they are read
+	ExportResultsStream(sid string, query *ExportResultsQueryParams) (*util.JSONIterator[map[string]interface{}], error)
+	// ExportResultsStreamWithContext is ExportResultsStream with the request and reading of results bound to ctx
+	ExportResultsStreamWithContext(ctx context.Context, sid string, query *ExportResultsQueryParams) (*util.JSONIterator[map[string]interface{}], error)
 
 	//interfaces that are auto-generated in interface_generated.go
 	ServicerGenerated
@@ -19,7 +19,10 @@
 package search
 
 import (
+	"context"
 	"net/http"
+
+	sdkutil "github.com/splunk/splunk-cloud-sdk-go/util"
 )
 
 // ServicerGenerated represents the interface for implementing all endpoints for this service
@@ -214,4 +217,247 @@ type ServicerGenerated interface {
 			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
 	*/
 	UpdateJob(sid string, updateJob UpdateJob, resp ...*http.Response) (*SearchJob, error)
+	/*
+		CreateDatasetWithContext - search service endpoint
+		Creates a dataset.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			datasetPost
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	CreateDatasetWithContext(ctx context.Context, datasetPost DatasetPost, resp ...*http.Response) (*Dataset, error)
+	/*
+		CreateFederatedConnectionWithContext - search service endpoint
+		Creates a new federated connection with information about how to connect to a remote index.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			federatedConnectionInput
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	CreateFederatedConnectionWithContext(ctx context.Context, federatedConnectionInput FederatedConnectionInput, resp ...*http.Response) (*FederatedConnection, error)
+	/*
+		CreateJobWithContext - search service endpoint
+		Creates a search job.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			searchJob
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	CreateJobWithContext(ctx context.Context, searchJob SearchJob, resp ...*http.Response) (*SearchJob, error)
+	/*
+		DeleteDatasetByIdWithContext - search service endpoint
+		Deletes a dataset with a specified dataset ID (datasetid).
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			datasetid: The dataset ID.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	DeleteDatasetByIdWithContext(ctx context.Context, datasetid string, resp ...*http.Response) error
+	/*
+		DeleteFederatedConnectionWithContext - search service endpoint
+		Deletes a federated connection with the specified name (connectionName)
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			connectionName: The name of the federated connection.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	DeleteFederatedConnectionWithContext(ctx context.Context, connectionName string, resp ...*http.Response) error
+	/*
+		DeleteJobWithContext - search service endpoint
+		Creates a search job that deletes events from an index. The events are deleted from the index in the specified module, based on the search criteria as specified by the predicate.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			deleteSearchJob
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	DeleteJobWithContext(ctx context.Context, deleteSearchJob DeleteSearchJob, resp ...*http.Response) (*DeleteSearchJob, error)
+	/*
+		ExportResultsWithContext - search service endpoint
+		Exports the search results for the job with the specified search ID (SID). Export the results as a CSV file or JSON file.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			sid: The search ID.
+			query: a struct pointer of valid query parameters for the endpoint, nil to send no query parameters
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ExportResultsWithContext(ctx context.Context, sid string, query *ExportResultsQueryParams, resp ...*http.Response) (*map[string]interface{}, error)
+	/*
+		GetAllFederatedConnectionsWithContext - search service endpoint
+		Returns all federated connections.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetAllFederatedConnectionsWithContext(ctx context.Context, resp ...*http.Response) (*ListFederatedConnections, error)
+	/*
+		GetDatasetByIdWithContext - search service endpoint
+		Returns a dataset with a specified dataset ID (datasetid).
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			datasetid: The dataset ID.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetDatasetByIdWithContext(ctx context.Context, datasetid string, resp ...*http.Response) (*Dataset, error)
+	/*
+		GetFederatedConnectionByNameWithContext - search service endpoint
+		Returns the federated connection with the specified name (connectionName).
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			connectionName: The name of the federated connection.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetFederatedConnectionByNameWithContext(ctx context.Context, connectionName string, resp ...*http.Response) (*FederatedConnection, error)
+	/*
+		GetJobWithContext - search service endpoint
+		Returns the search job with the specified search ID (SID).
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			sid: The search ID.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	GetJobWithContext(ctx context.Context, sid string, resp ...*http.Response) (*SearchJob, error)
+	/*
+		ListDatasetsWithContext - search service endpoint
+		Returns a list of all datasets.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListDatasetsWithContext(ctx context.Context, resp ...*http.Response) (*ListDatasets, error)
+	/*
+		ListEventsSummaryWithContext - search service endpoint
+		Returns an events summary for search ID (SID) search.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			sid: The search ID.
+			query: a struct pointer of valid query parameters for the endpoint, nil to send no query parameters
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListEventsSummaryWithContext(ctx context.Context, sid string, query *ListEventsSummaryQueryParams, resp ...*http.Response) (*ListSearchResultsResponse, error)
+	/*
+		ListFieldsSummaryWithContext - search service endpoint
+		Returns a fields stats summary of the events to-date, for search ID (SID) search.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			sid: The search ID.
+			query: a struct pointer of valid query parameters for the endpoint, nil to send no query parameters
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListFieldsSummaryWithContext(ctx context.Context, sid string, query *ListFieldsSummaryQueryParams, resp ...*http.Response) (*FieldsSummary, error)
+	/*
+		ListJobsWithContext - search service endpoint
+		Returns the matching list of search jobs.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			query: a struct pointer of valid query parameters for the endpoint, nil to send no query parameters
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListJobsWithContext(ctx context.Context, query *ListJobsQueryParams, resp ...*http.Response) ([]SearchJob, error)
+	/*
+		ListPreviewResultsWithContext - search service endpoint
+		Returns the preview search results for the job with the specified search ID (SID). Can be used when a job is running to return interim results.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			sid: The search ID.
+			query: a struct pointer of valid query parameters for the endpoint, nil to send no query parameters
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListPreviewResultsWithContext(ctx context.Context, sid string, query *ListPreviewResultsQueryParams, resp ...*http.Response) (*ListPreviewResultsResponse, error)
+	/*
+		ListResultsWithContext - search service endpoint
+		Returns the search results for the job with the specified search ID (SID).
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			sid: The search ID.
+			query: a struct pointer of valid query parameters for the endpoint, nil to send no query parameters
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListResultsWithContext(ctx context.Context, sid string, query *ListResultsQueryParams, resp ...*http.Response) (*ListSearchResultsResponse, error)
+	/*
+		ListTimeBucketsWithContext - search service endpoint
+		Returns the event distribution over time of the untransformed events read to-date, for search ID(SID) search.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			sid: The search ID.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	ListTimeBucketsWithContext(ctx context.Context, sid string, resp ...*http.Response) (*TimeBucketsSummary, error)
+	/*
+		PutFederatedConnectionByNameWithContext - search service endpoint
+		Creates or updates a federated connection with a specified name (connectionName).
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			connectionName: The name of the federated connection.
+			federatedConnectionInput
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	PutFederatedConnectionByNameWithContext(ctx context.Context, connectionName string, federatedConnectionInput FederatedConnectionInput, resp ...*http.Response) (*FederatedConnection, error)
+	/*
+		RefreshFederatedConnectionWithContext - search service endpoint
+		Refresh a federated connection to fetch new remote indexes and add/delete corresponding federated datasets.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			connectionName: The name of the federated connection.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	RefreshFederatedConnectionWithContext(ctx context.Context, connectionName string, resp ...*http.Response) error
+	/*
+		TestFederatedConnectionWithContext - search service endpoint
+		Test connection with remote EC instance using federated connection parameters.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			connectionName: The name of the federated connection.
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	TestFederatedConnectionWithContext(ctx context.Context, connectionName string, resp ...*http.Response) error
+	/*
+		UpdateDatasetByIdWithContext - search service endpoint
+		Modifies a dataset with a specified dataset ID (datasetid).
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			datasetid: The dataset ID.
+			datasetPatch
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	UpdateDatasetByIdWithContext(ctx context.Context, datasetid string, datasetPatch DatasetPatch, resp ...*http.Response) (*Dataset, error)
+	/*
+		UpdateJobWithContext - search service endpoint
+		Updates the search job with the specified search ID (SID) with an action.
+		Parameters:
+			ctx: the context.Context bound to the request, used for cancellation and deadlines
+			sid: The search ID.
+			updateJob
+			resp: an optional pointer to a http.Response to be populated by this method. NOTE: only the first resp pointer will be used if multiple are provided
+	*/
+	UpdateJobWithContext(ctx context.Context, sid string, updateJob UpdateJob, resp ...*http.Response) (*SearchJob, error)
+	// ListDatasetsPages returns a Pager over the pages of ListDatasets, which returns a single page
+	ListDatasetsPages() *sdkutil.Pager[Dataset]
+	// ListDatasetsAll returns the items of all pages of ListDatasets
+	ListDatasetsAll() ([]Dataset, error)
+	// ListDatasetsAllWithContext - ListDatasetsAll bound to ctx
+	ListDatasetsAllWithContext(ctx context.Context) ([]Dataset, error)
+	// ListEventsSummaryPages returns a Pager over the pages of ListEventsSummary, advancing the offset by the number of items of each page
+	ListEventsSummaryPages(sid string, query *ListEventsSummaryQueryParams) *sdkutil.Pager[map[string]interface{}]
+	// ListEventsSummaryAll returns the items of all pages of ListEventsSummary
+	ListEventsSummaryAll(sid string, query *ListEventsSummaryQueryParams) ([]map[string]interface{}, error)
+	// ListEventsSummaryAllWithContext - ListEventsSummaryAll bound to ctx
+	ListEventsSummaryAllWithContext(ctx context.Context, sid string, query *ListEventsSummaryQueryParams) ([]map[string]interface{}, error)
+	// ListJobsPages returns a Pager over the pages of ListJobs, which returns a single page
+	ListJobsPages(query *ListJobsQueryParams) *sdkutil.Pager[SearchJob]
+	// ListJobsAll returns the items of all pages of ListJobs
+	ListJobsAll(query *ListJobsQueryParams) ([]SearchJob, error)
+	// ListJobsAllWithContext - ListJobsAll bound to ctx
+	ListJobsAllWithContext(ctx context.Context, query *ListJobsQueryParams) ([]SearchJob, error)
+	// ListPreviewResultsPages returns a Pager over the pages of ListPreviewResults, advancing the offset by the number of items of each page
+	ListPreviewResultsPages(sid string, query *ListPreviewResultsQueryParams) *sdkutil.Pager[map[string]interface{}]
+	// ListPreviewResultsAll returns the items of all pages of ListPreviewResults
+	ListPreviewResultsAll(sid string, query *ListPreviewResultsQueryParams) ([]map[string]interface{}, error)
+	// ListPreviewResultsAllWithContext - ListPreviewResultsAll bound to ctx
+	ListPreviewResultsAllWithContext(ctx context.Context, sid string, query *ListPreviewResultsQueryParams) ([]map[string]interface{}, error)
+	// ListResultsPages returns a Pager over the pages of ListResults, advancing the offset by the number of items of each page
+	ListResultsPages(sid string, query *ListResultsQueryParams) *sdkutil.Pager[map[string]interface{}]
+	// ListResultsAll returns the items of all pages of ListResults
+	ListResultsAll(sid string, query *ListResultsQueryParams) ([]map[string]interface{}, error)
+	// ListResultsAllWithContext - ListResultsAll bound to ctx
+	ListResultsAllWithContext(ctx context.Context, sid string, query *ListResultsQueryParams) ([]map[string]interface{}, error)
 }
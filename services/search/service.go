@@ -5,12 +5,24 @@
 
 package search
 
-import "time"
+import (
+	"context"
+	"time"
+
+	"github.com/splunk/go-dependencies/services"
+	sdkservices "github.com/splunk/splunk-cloud-sdk-go/services"
+	"github.com/splunk/splunk-cloud-sdk-go/util"
+)
 
 // WaitForJob polls the job until it's completed or errors out
 func (s *Service) WaitForJob(jobID string, pollInterval time.Duration) (interface{}, error) {
+	return s.WaitForJobWithContext(context.Background(), jobID, pollInterval)
+}
+
+// WaitForJobWithContext polls the job until it's completed, errors out or ctx is done
+func (s *Service) WaitForJobWithContext(ctx context.Context, jobID string, pollInterval time.Duration) (interface{}, error) {
 	for {
-		job, err := s.GetJob(jobID)
+		job, err := s.getCurrentJob(ctx, jobID)
 		if err != nil {
 			return nil, err
 		}
@@ -19,7 +31,75 @@ func (s *Service) WaitForJob(jobID string, pollInterval time.Duration) (interfac
 		case SearchStatusDone, SearchStatusFailed, SearchStatusCanceled:
 			return *job.Status, nil
 		default:
-			time.Sleep(pollInterval)
+			timer := time.NewTimer(pollInterval)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, ctx.Err()
+			}
+		}
+	}
+}
+
+// getCurrentJob is GetJobWithContext revalidating any response cached by a services.ResponseCache, such that
+// the status of the job is current
+func (s *Service) getCurrentJob(ctx context.Context, sid string) (*SearchJob, error) {
+	pp := struct {
+		Sid string
+	}{
+		Sid: sid,
+	}
+	client := s.withContext(ctx).Client
+	u, err := client.BuildURLFromPathParams(nil, serviceCluster, `/search/v2/jobs/{{.Sid}}`, pp)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.Get(services.RequestParams{URL: u, Headers: map[string]string{"Cache-Control": "no-cache"}})
+	if response != nil {
+		defer response.Body.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+	var rb SearchJob
+	err = util.ParseResponse(&rb, response)
+	return &rb, err
+}
+
+// ExportResultsStream exports the search results for the job with the specified search ID (SID) in JSON format,
+// returning an iterator which decodes the results one at a time as they are read from the response, such that
+// large exports can be processed with bounded memory. The iterator must be closed once it is no longer needed.
+func (s *Service) ExportResultsStream(sid string, query *ExportResultsQueryParams) (*util.JSONIterator[map[string]interface{}], error) {
+	return s.ExportResultsStreamWithContext(context.Background(), sid, query)
+}
+
+// ExportResultsStreamWithContext is ExportResultsStream with the request and reading of results bound to ctx
+func (s *Service) ExportResultsStreamWithContext(ctx context.Context, sid string, query *ExportResultsQueryParams) (*util.JSONIterator[map[string]interface{}], error) {
+	var params ExportResultsQueryParams
+	if query != nil {
+		params = *query
+	}
+	// results can only be streamed in JSON format
+	params.OutputMode = ExportResultsoutputModeJson
+	pp := struct {
+		Sid string
+	}{
+		Sid: sid,
+	}
+	// the tenant and region of ctx apply to the url as well as the request, which is not limited by the
+	// client's timeout as the results are read as they are streamed
+	client := s.withContext(sdkservices.WithStreamedResponse(ctx)).Client
+	u, err := client.BuildURLFromPathParams(util.ParseURLParams(params), serviceCluster, `/search/v2/jobs/{{.Sid}}/export`, pp)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.Get(services.RequestParams{URL: u})
+	if err != nil {
+		if response != nil {
+			response.Body.Close()
 		}
+		return nil, err
 	}
+	return util.NewJSONIterator[map[string]interface{}](response.Body), nil
 }
@@ -0,0 +1,160 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package search
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/splunk/splunk-cloud-sdk-go/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// exportRT responds to export requests with NDJSON results
+type exportRT struct {
+	req *http.Request
+}
+
+func (rt *exportRT) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.req = req
+	body := "{\"host\":\"h1\",\"count\":\"1\"}\n{\"host\":\"h2\",\"count\":\"2\"}\n"
+	return &http.Response{Status: "200 OK", StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+}
+
+func TestExportResultsStream(t *testing.T) {
+	rt := &exportRT{}
+	client, err := services.NewClient(&services.Config{Token: "testtoken", Tenant: "mytenant", RoundTripper: rt})
+	require.NoError(t, err)
+	query := ExportResultsQueryParams{OutputMode: ExportResultsoutputModeCsv}.SetCount(2)
+	results, err := NewService(client).ExportResultsStream("sid1", &query)
+	require.NoError(t, err)
+	defer results.Close()
+	var hosts []string
+	for results.Next() {
+		hosts = append(hosts, results.Value()["host"].(string))
+	}
+	require.NoError(t, results.Err())
+	assert.Equal(t, []string{"h1", "h2"}, hosts)
+	assert.Equal(t, "/mytenant/search/v2/jobs/sid1/export", rt.req.URL.Path)
+	assert.Equal(t, "json", rt.req.URL.Query().Get("outputMode"), "results should be exported as JSON")
+	assert.Equal(t, "2", rt.req.URL.Query().Get("count"))
+	assert.Equal(t, ExportResultsoutputModeCsv, query.OutputMode, "the query should not be modified")
+
+	// the tenant of the context is used
+	results, err = NewService(client).ExportResultsStreamWithContext(services.WithTenant(context.Background(), "othertenant"), "sid1", nil)
+	require.NoError(t, err)
+	results.Close()
+	assert.Equal(t, "/othertenant/search/v2/jobs/sid1/export", rt.req.URL.Path)
+}
+
+// resultsRT responds to list results requests with the page of results at the requested offset
+type resultsRT struct {
+	offsets []string
+}
+
+func (rt *resultsRT) RoundTrip(req *http.Request) (*http.Response, error) {
+	offset := req.URL.Query().Get("offset")
+	rt.offsets = append(rt.offsets, offset)
+	pages := map[string]string{
+		"0": `{"results":[{"host":"h1"},{"host":"h2"}]}`,
+		"2": `{"results":[{"host":"h3"},{"host":"h4"}]}`,
+		"4": `{"results":[{"host":"h5"}]}`,
+	}
+	return &http.Response{Status: "200 OK", StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(pages[offset]))}, nil
+}
+
+func TestListResultsAll(t *testing.T) {
+	rt := &resultsRT{}
+	client, err := services.NewClient(&services.Config{Token: "testtoken", Tenant: "mytenant", RoundTripper: rt})
+	require.NoError(t, err)
+	query := ListResultsQueryParams{}.SetCount(2)
+	results, err := NewService(client).ListResultsAll("sid1", &query)
+	require.NoError(t, err)
+	var hosts []string
+	for _, result := range results {
+		hosts = append(hosts, result["host"].(string))
+	}
+	assert.Equal(t, []string{"h1", "h2", "h3", "h4", "h5"}, hosts)
+	assert.Equal(t, []string{"0", "2", "4"}, rt.offsets)
+	assert.Nil(t, query.Offset, "the query should not be modified")
+}
+
+func TestExportResultsStreamSlowBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for i := 0; i < 3; i++ {
+			fmt.Fprintf(w, "{\"host\":\"h%d\"}\n", i)
+			w.(http.Flusher).Flush()
+			time.Sleep(50 * time.Millisecond)
+		}
+	}))
+	defer server.Close()
+	client, err := services.NewClient(&services.Config{
+		Token:        "testtoken",
+		Tenant:       "mytenant",
+		Scheme:       "http",
+		OverrideHost: strings.TrimPrefix(server.URL, "http://"),
+		Timeout:      50 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	start := time.Now()
+	results, err := NewService(client).ExportResultsStream("sid1", nil)
+	require.NoError(t, err)
+	defer results.Close()
+	count := 0
+	for results.Next() {
+		count++
+	}
+	require.NoError(t, results.Err(), "the client timeout should not limit reading the results")
+	assert.Equal(t, 3, count)
+	assert.True(t, time.Since(start) > 100*time.Millisecond, "the results should have been streamed for longer than the timeout")
+}
+
+// jobRT responds to get job requests with the next of statuses, allowing the response to be cached
+type jobRT struct {
+	statuses []string
+	requests []*http.Request
+}
+
+func (rt *jobRT) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.requests = append(rt.requests, req)
+	status := rt.statuses[0]
+	if len(rt.statuses) > 1 {
+		rt.statuses = rt.statuses[1:]
+	}
+	body := fmt.Sprintf(`{"sid":"sid1","query":"search","status":%q}`, status)
+	header := http.Header{"Cache-Control": {"max-age=60"}}
+	return &http.Response{Status: "200 OK", StatusCode: 200, Header: header, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+}
+
+func TestWaitForJobRevalidatesCachedJob(t *testing.T) {
+	rt := &jobRT{statuses: []string{"running", "running", "done"}}
+	cache := services.NewResponseCache(services.ResponseCacheConfig{TTLs: map[string]time.Duration{"search": time.Minute}})
+	client, err := services.NewClient(&services.Config{Token: "testtoken", Tenant: "mytenant", RoundTripper: rt, ResponseCache: cache})
+	require.NoError(t, err)
+	status, err := NewService(client).WaitForJob("sid1", time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, SearchStatusDone, status)
+	require.Len(t, rt.requests, 3, "the job should not have been read from the cache")
+	assert.Equal(t, "no-cache", rt.requests[0].Header.Get("Cache-Control"))
+}
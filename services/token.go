@@ -0,0 +1,161 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/splunk/splunk-cloud-sdk-go/idp"
+)
+
+// minRenewRetryInterval is the minimum time to wait after a background token renewal, successful or not, before
+// renewing again, a variable such that tests can shorten it
+var minRenewRetryInterval = 5 * time.Second
+
+// tokenRefresh is a token retrieval in progress, shared by all requests waiting for a new token
+type tokenRefresh struct {
+	done chan struct{}
+	tctx *idp.Context
+	err  error
+}
+
+// expiresAt returns when tctx expires, the exp claim of JWT access tokens, tokens without an expiry return the
+// zero time
+func expiresAt(tctx *idp.Context) time.Time {
+	if tctx == nil {
+		return time.Time{}
+	}
+	return tctx.ExpiresAt()
+}
+
+// tokenExpiring returns true if there is no token or tctx expires within the client's tokenExpireWindow. Tokens
+// without an expiry, e.g. static tokens, are never expiring and are only renewed once rejected with a 401
+// response by AuthnResponseHandler
+func (c *BaseClient) tokenExpiring(tctx *idp.Context) bool {
+	if tctx == nil {
+		return true
+	}
+	exp := expiresAt(tctx)
+	if exp.IsZero() {
+		return false
+	}
+	return time.Now().Add(c.tokenExpireWindow).Unix() >= exp.Unix()
+}
+
+// refreshToken retrieves a new access token using tr unless the client's token has already been replaced since
+// staleToken was read. Concurrent calls share a single retrieval, which is not cancelled if ctx is cancelled
+// so that other callers waiting on it are not affected.
+func (c *BaseClient) refreshToken(ctx context.Context, tr idp.TokenRetriever, staleToken string) (*idp.Context, error) {
+	c.tokenMux.Lock()
+	if cur := c.tokenContext.Load(); cur != nil && cur.AccessToken != staleToken && !c.tokenExpiring(cur) {
+		c.tokenMux.Unlock()
+		return cur, nil
+	}
+	refresh := c.tokenRefresh
+	if refresh == nil {
+		refresh = &tokenRefresh{done: make(chan struct{})}
+		c.tokenRefresh = refresh
+		go func() {
+			refresh.tctx, refresh.err = c.retrieveToken(context.WithoutCancel(ctx), tr)
+			c.tokenMux.Lock()
+			if refresh.err == nil {
+				// Update the client such that future requests will use the new access token and retain context information
+				c.tokenContext.Store(refresh.tctx)
+			}
+			c.tokenRefresh = nil
+			c.tokenMux.Unlock()
+			close(refresh.done)
+		}()
+	}
+	c.tokenMux.Unlock()
+
+	select {
+	case <-refresh.done:
+		return refresh.tctx, refresh.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// accessToken returns the access token sent with request
+func accessToken(request *Request) string {
+	return strings.TrimPrefix(request.Header.Get("Authorization"), AuthorizationType+" ")
+}
+
+// renewTokens renews the client's token in the background a tokenExpireWindow before requests would otherwise
+// block to renew it, until Close is called
+func (c *BaseClient) renewTokens() {
+	defer close(c.renewDone)
+	for {
+		tctx := c.tokenContext.Load()
+		exp := expiresAt(tctx)
+		if exp.IsZero() {
+			// Static tokens never need renewing
+			return
+		}
+		if !c.sleep(time.Until(exp.Add(-2 * c.tokenExpireWindow))) {
+			return
+		}
+		if c.tokenContext.Load() != tctx {
+			// Renewed by a request in the meantime
+			continue
+		}
+		renewed, err := c.refreshToken(context.Background(), c.tokenRetriever, tctx.AccessToken)
+		if err == nil && renewed.AccessToken == tctx.AccessToken {
+			// The retriever returns the same token, e.g. a static token, so renewing it again would not help
+			return
+		}
+		// Tokens may be renewed with tokens which are already expiring, e.g. short lived ones, so wait before
+		// renewing again rather than renewing continuously
+		wait := minRenewRetryInterval
+		if err != nil {
+			// Retry ahead of requests needing the token, leaving requests to renew it themselves once it is expiring
+			if retry := time.Until(exp.Add(-c.tokenExpireWindow)) / 2; retry > wait {
+				wait = retry
+			}
+		}
+		if !c.sleep(wait) {
+			return
+		}
+	}
+}
+
+// sleep waits for d, returning false if Close is called first
+func (c *BaseClient) sleep(d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-c.renewStop:
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// Close stops the background token renewal started by Config.RenewTokensInBackground, it is safe to call
+// Close on clients without background renewal and more than once
+func (c *BaseClient) Close() error {
+	c.closeOnce.Do(func() {
+		if c.renewStop != nil {
+			close(c.renewStop)
+			<-c.renewDone
+		}
+	})
+	return nil
+}
@@ -0,0 +1,246 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package services
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/splunk/go-dependencies/services"
+	"github.com/splunk/splunk-cloud-sdk-go/idp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingTokenRetriever returns an expired token on its first call and tokens expiring after expiresIn afterwards
+type countingTokenRetriever struct {
+	calls     int32
+	expiresIn int
+	delay     time.Duration
+}
+
+func (tr *countingTokenRetriever) GetTokenContext() (*idp.Context, error) {
+	n := atomic.AddInt32(&tr.calls, 1)
+	if n == 1 {
+		return &idp.Context{AccessToken: "token1", StartTime: time.Now().Unix() - 10, ExpiresIn: 1}, nil
+	}
+	time.Sleep(tr.delay)
+	return &idp.Context{AccessToken: fmt.Sprintf("token%d", n), StartTime: time.Now().Unix(), ExpiresIn: tr.expiresIn}, nil
+}
+
+// authRT records the Authorization header of each request it receives, responding 401 to unauthorizedToken
+type authRT struct {
+	mux               sync.Mutex
+	tokens            []string
+	unauthorizedToken string
+}
+
+func (rt *authRT) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.mux.Lock()
+	defer rt.mux.Unlock()
+	token := req.Header.Get("Authorization")
+	rt.tokens = append(rt.tokens, token)
+	b := ioutil.NopCloser(bytes.NewReader([]byte("")))
+	if token == AuthorizationType+" "+rt.unauthorizedToken {
+		return &http.Response{Status: "401 Unauthorized", StatusCode: 401, Body: b}, nil
+	}
+	return &http.Response{Status: "200 OK", StatusCode: 200, Body: b}, nil
+}
+
+func TestConcurrentTokenRefreshSingleFlight(t *testing.T) {
+	tr := &countingTokenRetriever{expiresIn: 3600, delay: 50 * time.Millisecond}
+	rt := &authRT{}
+	client, err := NewClient(&Config{TokenRetriever: tr, Tenant: "mytenant", RoundTripper: rt})
+	require.NoError(t, err)
+	u, err := client.BuildURLFromPathParams(nil, "api", `/myservice/v1/widgets`, nil)
+	require.NoError(t, err)
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.Get(services.RequestParams{URL: u})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	// One retrieval on client initialization and exactly one renewal shared by all requests
+	assert.Equal(t, int32(2), atomic.LoadInt32(&tr.calls))
+	require.Equal(t, 20, len(rt.tokens))
+	for _, token := range rt.tokens {
+		assert.Equal(t, "Bearer token2", token)
+	}
+}
+
+func TestConcurrentUnauthorizedSingleFlight(t *testing.T) {
+	tr := &countingTokenRetriever{expiresIn: 3600, delay: 50 * time.Millisecond}
+	rt := &authRT{unauthorizedToken: "token2"}
+	client, err := NewClient(&Config{TokenRetriever: tr, Tenant: "mytenant", RoundTripper: rt})
+	require.NoError(t, err)
+	client.UpdateTokenContext(&idp.Context{AccessToken: "token2", StartTime: time.Now().Unix(), ExpiresIn: 3600})
+	atomic.StoreInt32(&tr.calls, 2)
+	client.responseHandlers = []ResponseHandler{AuthnResponseHandler{TokenRetriever: tr}}
+	u, err := client.BuildURLFromPathParams(nil, "api", `/myservice/v1/widgets`, nil)
+	require.NoError(t, err)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(services.RequestParams{URL: u})
+			assert.NoError(t, err)
+			assert.Equal(t, 200, resp.StatusCode)
+		}()
+	}
+	wg.Wait()
+	// All requests rejected with the old token should share a single renewal
+	assert.Equal(t, int32(3), atomic.LoadInt32(&tr.calls))
+	assert.Equal(t, "token3", client.tokenContext.Load().AccessToken)
+}
+
+// shortenRenewRetryInterval shortens minRenewRetryInterval for the duration of a test
+func shortenRenewRetryInterval(t *testing.T, d time.Duration) {
+	interval := minRenewRetryInterval
+	minRenewRetryInterval = d
+	t.Cleanup(func() { minRenewRetryInterval = interval })
+}
+
+func TestBackgroundTokenRenewal(t *testing.T) {
+	shortenRenewRetryInterval(t, 500*time.Millisecond)
+	tr := &countingTokenRetriever{expiresIn: 3}
+	rt := &authRT{}
+	client, err := NewClient(&Config{
+		TokenRetriever:          tr,
+		Tenant:                  "mytenant",
+		RoundTripper:            rt,
+		TokenExpireWindow:       time.Second,
+		RenewTokensInBackground: true,
+	})
+	require.NoError(t, err)
+	// The initial token has already expired so it is renewed straight away, then a second before requests
+	// would need to renew it
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&tr.calls) >= 3 }, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, client.Close())
+	calls := atomic.LoadInt32(&tr.calls)
+	assert.True(t, client.tokenContext.Load().StartTime+3 > time.Now().Unix()+1, "token should not be expiring")
+	u, err := client.BuildURLFromPathParams(nil, "api", `/myservice/v1/widgets`, nil)
+	require.NoError(t, err)
+	_, err = client.Get(services.RequestParams{URL: u})
+	require.NoError(t, err)
+	assert.Equal(t, calls, atomic.LoadInt32(&tr.calls), "request should not have renewed the token")
+	// Close should be safe to call again
+	require.NoError(t, client.Close())
+}
+
+func TestBackgroundTokenRenewalShortLivedTokens(t *testing.T) {
+	shortenRenewRetryInterval(t, 200*time.Millisecond)
+	// Each token expires within 2*TokenExpireWindow, so is expiring as soon as it is renewed
+	tr := &countingTokenRetriever{expiresIn: 1}
+	client, err := NewClient(&Config{
+		TokenRetriever:          tr,
+		Tenant:                  "mytenant",
+		RoundTripper:            &authRT{},
+		TokenExpireWindow:       time.Second,
+		RenewTokensInBackground: true,
+	})
+	require.NoError(t, err)
+	time.Sleep(time.Second)
+	require.NoError(t, client.Close())
+	assert.LessOrEqual(t, atomic.LoadInt32(&tr.calls), int32(7), "renewals should wait minRenewRetryInterval")
+}
+
+func TestBackgroundTokenRenewalStaticToken(t *testing.T) {
+	// A static JWT which is expiring cannot be renewed, as the retriever returns the same token
+	token := "eyJhbGciOiJFUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"exp":%d}`, time.Now().Unix()+1))) + ".sig"
+	client, err := NewClient(&Config{
+		Token:                   token,
+		Tenant:                  "mytenant",
+		TokenExpireWindow:       time.Second,
+		RenewTokensInBackground: true,
+	})
+	require.NoError(t, err)
+	select {
+	case <-client.renewDone:
+	case <-time.After(5 * time.Second):
+		t.Fatal("background renewal should stop")
+	}
+	require.NoError(t, client.Close())
+}
+
+func TestCloseWithoutBackgroundRenewal(t *testing.T) {
+	client, err := NewClient(&Config{Token: "testtoken"})
+	require.NoError(t, err)
+	assert.NoError(t, client.Close())
+}
+
+func TestTokenExpiringUsesExpClaim(t *testing.T) {
+	client, err := NewClient(&Config{Token: "testtoken"})
+	require.NoError(t, err)
+	defer client.Close()
+	jwt := func(exp int64) string {
+		return "eyJhbGciOiJFUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"exp":%d}`, exp))) + ".sig"
+	}
+	now := time.Now().Unix()
+	// the exp claim of the token takes precedence over the local start time
+	assert.True(t, client.tokenExpiring(&idp.Context{AccessToken: jwt(now - 10), StartTime: now, ExpiresIn: 3600}))
+	assert.False(t, client.tokenExpiring(&idp.Context{AccessToken: jwt(now + 3600), StartTime: now - 7200, ExpiresIn: 3600}))
+	// opaque tokens expire ExpiresIn after the start time
+	assert.False(t, client.tokenExpiring(&idp.Context{AccessToken: "opaque", StartTime: now, ExpiresIn: 3600}))
+	assert.True(t, client.tokenExpiring(&idp.Context{AccessToken: "opaque", StartTime: now - 7200, ExpiresIn: 3600}))
+	assert.True(t, client.tokenExpiring(nil))
+	// tokens without an expiry are never expiring
+	assert.False(t, client.tokenExpiring(&idp.Context{AccessToken: "opaque"}))
+}
+
+// staticTokenRetriever returns the same token without an expiry, counting its calls
+type staticTokenRetriever struct {
+	calls int32
+}
+
+func (tr *staticTokenRetriever) GetTokenContext() (*idp.Context, error) {
+	atomic.AddInt32(&tr.calls, 1)
+	return &idp.Context{AccessToken: "static"}, nil
+}
+
+func TestStaticTokenNotRefreshed(t *testing.T) {
+	tr := &staticTokenRetriever{}
+	rt := &authRT{}
+	client, err := NewClient(&Config{TokenRetriever: tr, Tenant: "mytenant", RoundTripper: rt})
+	require.NoError(t, err)
+	u, err := client.BuildURLFromPathParams(nil, "api", `/myservice/v1/widgets`, nil)
+	require.NoError(t, err)
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.Get(services.RequestParams{URL: u})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	// Only the retrieval on client initialization, requests do not refresh a token without an expiry
+	assert.Equal(t, int32(1), atomic.LoadInt32(&tr.calls))
+	assert.Len(t, rt.tokens, 20)
+}
@@ -0,0 +1,60 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package services
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/splunk/splunk-cloud-sdk-go/idp"
+)
+
+// Tracer observes the requests made by a BaseClient, e.g. to record traces and metrics - see the otelsdk
+// package for an OpenTelemetry implementation. The funcs returned are called when the traced work ends.
+type Tracer interface {
+	// StartRequest is called by DoRequestWithContext before any token renewal or attempts are made, the
+	// returned context is used for the rest of the request
+	StartRequest(ctx context.Context, op Operation, tenant string) (context.Context, func(response *http.Response, err error))
+	// StartAttempt is called before each attempt of a request is sent, after any RequestHandlers, and
+	// may set headers on the request e.g. to propagate the trace
+	StartAttempt(request *Request) func(response *http.Response, err error)
+	// StartTokenRefresh is called before a new access token is retrieved
+	StartTokenRefresh(ctx context.Context) (context.Context, func(err error))
+}
+
+// noopTracer is used when no Tracer is configured
+type noopTracer struct{}
+
+func (noopTracer) StartRequest(ctx context.Context, op Operation, tenant string) (context.Context, func(*http.Response, error)) {
+	return ctx, func(*http.Response, error) {}
+}
+
+func (noopTracer) StartAttempt(request *Request) func(*http.Response, error) {
+	return func(*http.Response, error) {}
+}
+
+func (noopTracer) StartTokenRefresh(ctx context.Context) (context.Context, func(error)) {
+	return ctx, func(error) {}
+}
+
+// retrieveToken retrieves a new access token using tr, traced by the client's Tracer
+func (c *BaseClient) retrieveToken(ctx context.Context, tr idp.TokenRetriever) (*idp.Context, error) {
+	ctx, end := c.tracer.StartTokenRefresh(ctx)
+	tctx, err := idp.RetrieveTokenContext(ctx, tr)
+	end(err)
+	return tctx, err
+}
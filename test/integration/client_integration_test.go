@@ -84,10 +84,11 @@ func getClient(t *testing.T) *sdk.Client {
 
 func getClientWithTimeout(t *testing.T, timeout time.Duration) *sdk.Client {
 	client, err := sdk.NewClient(&services.Config{
-		Token:   testutils.TestAuthenticationToken,
-		Host:    testutils.TestSplunkCloudHost,
-		Tenant:  testutils.TestTenant,
-		Timeout: timeout,
+		Token:        testutils.TestAuthenticationToken,
+		Host:         testutils.TestSplunkCloudHost,
+		Tenant:       testutils.TestTenant,
+		Timeout:      timeout,
+		RoundTripper: testutils.CassetteRoundTripper(),
 	})
 	require.Emptyf(t, err, "error calling service.NewClient(): %s", err)
 	return client
@@ -19,15 +19,19 @@ package utils
 import (
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"path/filepath"
 	"runtime"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/splunk/splunk-cloud-sdk-go/idp"
 	"github.com/splunk/splunk-cloud-sdk-go/sdk"
 	"github.com/splunk/splunk-cloud-sdk-go/services"
+	"github.com/splunk/splunk-cloud-sdk-go/util"
 )
 
 // GetFilename uses reflection to get current filename
@@ -60,8 +64,16 @@ func init() {
 	IdpHost = os.Getenv("IDP_HOST_TENANT_SCOPED")
 }
 
-// RunSuffix - run instance identifier suffix based on timestamp
-var RunSuffix = time.Now().Unix()
+// RunSuffix - run instance identifier suffix based on timestamp, or TEST_RUN_SUFFIX such that the resource names
+// of replayed tests match the recording
+var RunSuffix = runSuffix()
+
+func runSuffix() int64 {
+	if suffix, err := strconv.ParseInt(os.Getenv("TEST_RUN_SUFFIX"), 10, 64); err == nil {
+		return suffix
+	}
+	return time.Now().Unix()
+}
 
 // TestSplunkCloudHost - the url for the test api to be used
 var TestSplunkCloudHost string
@@ -117,5 +129,42 @@ func MakeSdkClient(tr idp.TokenRetriever, tenant string) (*sdk.Client, error) {
 		Host:           TestSplunkCloudHost,
 		Tenant:         tenant,
 		Timeout:        TestTimeOut,
+		RoundTripper:   CassetteRoundTripper(),
 	})
 }
+
+var (
+	// cassettes are the recorders created by CassetteRoundTripper keyed by path, guarded by cassettesMux as
+	// clients may be created by parallel tests
+	cassettes    = map[string]*util.Recorder{}
+	cassettesMux sync.Mutex
+)
+
+// CassetteRoundTripper returns a recorder for the cassette named by TEST_CASSETTE, in the mode named by
+// TEST_RECORD_MODE, or nil to send requests to the test environment if TEST_CASSETTE is not set. The tenant is
+// scrubbed from recordings and replaced with "testtenant".
+func CassetteRoundTripper() http.RoundTripper {
+	path := os.Getenv("TEST_CASSETTE")
+	if path == "" {
+		return nil
+	}
+	cassettesMux.Lock()
+	defer cassettesMux.Unlock()
+	if recorder, ok := cassettes[path]; ok {
+		return recorder
+	}
+	mode, err := util.ParseRecorderMode(os.Getenv("TEST_RECORD_MODE"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	replacements := map[string]string{}
+	if TestTenant != "" {
+		replacements[TestTenant] = "testtenant"
+	}
+	recorder, err := util.NewRecorder(path, util.RecorderOptions{Mode: mode, Replacements: replacements})
+	if err != nil {
+		log.Fatal(err)
+	}
+	cassettes[path] = recorder
+	return recorder
+}
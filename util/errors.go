@@ -18,11 +18,29 @@ package util
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 )
 
+// RequestIDHeader uniquely identifies a request in the platform, found in response headers
+const RequestIDHeader = "X-Request-Id"
+
+// Categories of HTTPError which can be matched using errors.Is, e.g. errors.Is(err, util.ErrNotFound)
+var (
+	// ErrUnauthorized matches HTTPErrors with a 401 status code
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrNotFound matches HTTPErrors with a 404 status code
+	ErrNotFound = errors.New("not found")
+	// ErrConflict matches HTTPErrors with a 409 status code
+	ErrConflict = errors.New("conflict")
+	// ErrRateLimited matches HTTPErrors with a 429 status code
+	ErrRateLimited = errors.New("rate limited")
+	// ErrServerError matches HTTPErrors with a 5xx status code
+	ErrServerError = errors.New("server error")
+)
+
 // HTTPError is raised when status code is not 2xx
 type HTTPError struct {
 	HTTPStatusCode int
@@ -31,6 +49,31 @@ type HTTPError struct {
 	Code           string      `json:"code,omitempty"`
 	MoreInfo       string      `json:"moreInfo,omitempty"`
 	Details        interface{} `json:"details,omitempty"`
+	// Service is the name of the service which returned the error, e.g. "search" or "idp"
+	Service string `json:"service,omitempty"`
+	// Operation is the name of the operation which returned the error, e.g. "ListJobs"
+	Operation string `json:"operation,omitempty"`
+	// RequestID uniquely identifies the request in the platform, include it when reporting issues
+	RequestID string `json:"requestId,omitempty"`
+	// Attempts is the number of times the request was sent, including retries
+	Attempts uint `json:"attempts,omitempty"`
+	// Body is the raw response body
+	Body []byte `json:"-"`
+}
+
+// NewHTTPError returns an HTTPError for response, body is the response body which has already been read and
+// is decoded into Message, Code, MoreInfo and Details where possible
+func NewHTTPError(response *http.Response, body []byte) *HTTPError {
+	httpErr := &HTTPError{
+		HTTPStatusCode: response.StatusCode,
+		HTTPStatus:     response.Status,
+		RequestID:      response.Header.Get(RequestIDHeader),
+		Body:           body,
+	}
+	if len(body) > 0 {
+		_ = json.Unmarshal(body, httpErr)
+	}
+	return httpErr
 }
 
 // This allows HTTPError to satisfy the error interface
@@ -43,24 +86,39 @@ func (he *HTTPError) Error() string {
 	return string(jsonErrMsg)
 }
 
+// Is reports whether the error's status code belongs to target, one of ErrUnauthorized, ErrNotFound,
+// ErrConflict, ErrRateLimited or ErrServerError
+func (he *HTTPError) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return he.HTTPStatusCode == http.StatusUnauthorized
+	case ErrNotFound:
+		return he.HTTPStatusCode == http.StatusNotFound
+	case ErrConflict:
+		return he.HTTPStatusCode == http.StatusConflict
+	case ErrRateLimited:
+		return he.HTTPStatusCode == http.StatusTooManyRequests
+	case ErrServerError:
+		return he.HTTPStatusCode >= 500 && he.HTTPStatusCode < 600
+	}
+	return false
+}
+
 // ParseHTTPStatusCodeInResponse returns http response and HTTPError struct based on response status code
 func ParseHTTPStatusCodeInResponse(response *http.Response) (*http.Response, error) {
 	if response != nil && (response.StatusCode < 200 || response.StatusCode >= 400) {
-		httpErr := HTTPError{
-			HTTPStatusCode: response.StatusCode,
-			HTTPStatus:     response.Status,
+		if response.Body == nil {
+			return response, NewHTTPError(response, nil)
+		}
+		body, err := ioutil.ReadAll(response.Body)
+		if err != nil {
+			return response, NewHTTPError(response, body)
 		}
-		if response.Body != nil {
-			body, err := ioutil.ReadAll(response.Body)
-			if err != nil {
-				return response, &httpErr
-			}
-			err = json.Unmarshal(body, &httpErr)
-			if err != nil {
-				return nil, &httpErr
-			}
+		httpErr := NewHTTPError(response, body)
+		if err := json.Unmarshal(body, &struct{}{}); err != nil {
+			return nil, httpErr
 		}
-		return response, &httpErr
+		return response, httpErr
 	}
 	return response, nil
 }
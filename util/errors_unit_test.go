@@ -18,11 +18,14 @@ package util
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestParseHTTPStatusCodeInResponseOKResponse(t *testing.T) {
@@ -80,3 +83,42 @@ func TestParseHTTPStatusCodeInResponseBodyMsg(t *testing.T) {
 	assert.Equal(t, "Validation Failed", err.(*HTTPError).Message)
 	assert.Equal(t, err.Error(), expectErrMsg)
 }
+
+func TestHTTPErrorIs(t *testing.T) {
+	categories := []error{ErrUnauthorized, ErrNotFound, ErrConflict, ErrRateLimited, ErrServerError}
+	tests := []struct {
+		statusCode int
+		category   error
+	}{
+		{401, ErrUnauthorized},
+		{404, ErrNotFound},
+		{409, ErrConflict},
+		{429, ErrRateLimited},
+		{500, ErrServerError},
+		{503, ErrServerError},
+		{400, nil},
+	}
+	for _, tt := range tests {
+		var err error = fmt.Errorf("wrapped: %w", &HTTPError{HTTPStatusCode: tt.statusCode})
+		for _, category := range categories {
+			assert.Equal(t, category == tt.category, errors.Is(err, category), "status code %d, category %v", tt.statusCode, category)
+		}
+	}
+}
+
+func TestParseHTTPStatusCodeInResponseRequestIDAndBody(t *testing.T) {
+	body := []byte(`{"code": "1019","message": "Not Found"}`)
+	httpResp := &http.Response{
+		StatusCode: 404,
+		Status:     "404 Not Found",
+		Header:     http.Header{RequestIDHeader: []string{"1234"}},
+		Body:       ioutil.NopCloser(bytes.NewReader(body)),
+	}
+	_, err := ParseHTTPStatusCodeInResponse(httpResp)
+	var httpErr *HTTPError
+	require.True(t, errors.As(err, &httpErr))
+	assert.True(t, errors.Is(err, ErrNotFound))
+	assert.Equal(t, "1234", httpErr.RequestID)
+	assert.Equal(t, body, httpErr.Body)
+	assert.Equal(t, `{"HTTPStatusCode":404,"HTTPStatus":"404 Not Found","message":"Not Found","code":"1019","requestId":"1234"}`, err.Error())
+}
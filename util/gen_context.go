@@ -0,0 +1,370 @@
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type filesFlag []string
+
+func (i *filesFlag) String() string {
+	return "service file(s) containing struct"
+}
+
+func (i *filesFlag) Set(value string) error {
+	*i = append(*i, value)
+	return nil
+}
+
+var options struct {
+	service        string
+	serviceFiles   filesFlag
+	structName     string
+	outputFile     string
+	operationsFile string
+}
+
+const header = `/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Code generated by gen_context.go. DO NOT EDIT.
+
+package %s
+
+import (
+%s)
+
+// contextClient is implemented by clients which can bind requests to a context.Context, see services.BaseClient.WithContext
+type contextClient interface {
+	WithContext(ctx context.Context) services.IClient
+}
+
+// withContext returns a copy of the service whose requests are bound to ctx, if the client does not
+// implement contextClient then requests are made without ctx
+func (s *%s) withContext(ctx context.Context) *%s {
+	if c, ok := s.Client.(contextClient); ok {
+		return &%s{Client: c.WithContext(ctx)}
+	}
+	return s
+}
+`
+
+const operationsHeader = `/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Code generated by gen_context.go. DO NOT EDIT.
+
+package %s
+
+import (
+	"net/http"
+
+	sdkservices "github.com/splunk/splunk-cloud-sdk-go/services"
+)
+
+// register this service's endpoints so that requests can be matched to their operation
+func init() {
+	sdkservices.RegisterOperations(
+`
+
+// httpMethods maps the IClient request functions to their HTTP method constants
+var httpMethods = map[string]string{
+	"Get":    "http.MethodGet",
+	"Post":   "http.MethodPost",
+	"Put":    "http.MethodPut",
+	"Patch":  "http.MethodPatch",
+	"Delete": "http.MethodDelete",
+}
+
+const ctxParamDoc = "ctx: the context.Context bound to the request, used for cancellation and deadlines"
+
+// Prints an error message and exits.
+func fatal(msg string, args ...interface{}) {
+	msg = fmt.Sprintf(msg, args...)
+	fmt.Fprintf(os.Stderr, "error: %s\n", msg)
+	os.Exit(1)
+}
+
+// Set up flags
+func init() {
+	flag.StringVar(&options.service, "svc", "", "service name")
+	flag.Var(&options.serviceFiles, "sf", "service file(s) containing struct")
+	flag.StringVar(&options.structName, "s", "Service", "struct to generate context variants for")
+	flag.StringVar(&options.outputFile, "o", "service_context_generated.go", "output file name, relative to the service directory")
+	flag.StringVar(&options.operationsFile, "oo", "operations_generated.go", "operations output file name, relative to the service directory")
+	flag.Parse()
+}
+
+func main() {
+	fset := token.NewFileSet()
+	var pkgName string
+	var buf bytes.Buffer
+	var ops bytes.Buffer
+	// the header always needs context and the go-dependencies services package
+	used := map[string]bool{"context": true, "services": true}
+	importPaths := map[string]string{"context": "context"}
+	for _, v := range options.serviceFiles {
+		file := filepath.Join(options.service, v)
+		fmt.Printf("generating context variants from %s\n", file)
+		f, err := parser.ParseFile(fset, file, nil, parser.ParseComments)
+		if err != nil {
+			fatal("%v", err)
+		}
+		pkgName = f.Name.Name
+		// carry over the source imports so that parameter and result types resolve
+		for _, spec := range f.Imports {
+			importPath, err := strconv.Unquote(spec.Path.Value)
+			if err != nil {
+				fatal("%v", err)
+			}
+			name := filepath.Base(importPath)
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			importPaths[name] = importPath
+		}
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || !fn.Name.IsExported() || !isReceiver(fn, options.structName) {
+				continue
+			}
+			ast.Inspect(fn.Type, func(n ast.Node) bool {
+				if sel, ok := n.(*ast.SelectorExpr); ok {
+					if ident, ok := sel.X.(*ast.Ident); ok {
+						used[ident.Name] = true
+					}
+				}
+				return true
+			})
+			if err := writeVariant(&buf, fset, fn); err != nil {
+				fatal("%s: %v", fn.Name.Name, err)
+			}
+			if err := writeOperation(&ops, fn); err != nil {
+				fatal("%s: %v", fn.Name.Name, err)
+			}
+		}
+	}
+	if pkgName == "" {
+		fatal("no service files specified")
+	}
+	src := fmt.Sprintf(header, pkgName, importBlock(used, importPaths), options.structName, options.structName, options.structName) + buf.String()
+	out := filepath.Join(options.service, options.outputFile)
+	if err := os.WriteFile(out, []byte(src), 0644); err != nil {
+		fatal("%v", err)
+	}
+	src = fmt.Sprintf(operationsHeader, pkgName) + ops.String() + "\t)\n}\n"
+	out = filepath.Join(options.service, options.operationsFile)
+	if err := os.WriteFile(out, []byte(src), 0644); err != nil {
+		fatal("%v", err)
+	}
+}
+
+// writeOperation writes the sdkservices.Operation called by fn to buf, found from the path template passed
+// to BuildURLFromPathParams and the IClient request function called
+func writeOperation(buf *bytes.Buffer, fn *ast.FuncDecl) error {
+	var template, method string
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if client, ok := sel.X.(*ast.SelectorExpr); !ok || client.Sel.Name != "Client" {
+			return true
+		}
+		if sel.Sel.Name == "BuildURLFromPathParams" && len(call.Args) == 4 {
+			if lit, ok := call.Args[2].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+				template = lit.Value
+			}
+		} else if m, ok := httpMethods[sel.Sel.Name]; ok {
+			method = m
+		}
+		return true
+	})
+	if template == "" || method == "" {
+		return fmt.Errorf("unable to determine the endpoint called")
+	}
+	segments := strings.Split(strings.Trim(template, "`\"/"), "/")
+	service := segments[0]
+	if service == "system" && len(segments) > 1 {
+		service = segments[1]
+	}
+	fmt.Fprintf(buf, "\t\tsdkservices.Operation{Service: %q, Name: %q, Method: %s, PathTemplate: %s},\n", service, fn.Name.Name, method, template)
+	return nil
+}
+
+// importBlock returns the import specs for the used package names, standard library packages first
+func importBlock(used map[string]bool, importPaths map[string]string) string {
+	var std, other []string
+	for name := range used {
+		importPath, ok := importPaths[name]
+		if !ok {
+			fatal("unable to resolve import for package %s", name)
+		}
+		spec := strconv.Quote(importPath)
+		if filepath.Base(importPath) != name {
+			spec = name + " " + spec
+		}
+		if strings.Contains(strings.Split(importPath, "/")[0], ".") {
+			other = append(other, spec)
+		} else {
+			std = append(std, spec)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(other)
+	var b strings.Builder
+	for _, spec := range std {
+		b.WriteString("\t" + spec + "\n")
+	}
+	if len(std) > 0 && len(other) > 0 {
+		b.WriteString("\n")
+	}
+	for _, spec := range other {
+		b.WriteString("\t" + spec + "\n")
+	}
+	return b.String()
+}
+
+// isReceiver returns whether fn is a method with a *structName receiver
+func isReceiver(fn *ast.FuncDecl, structName string) bool {
+	if fn.Recv == nil || len(fn.Recv.List) != 1 {
+		return false
+	}
+	star, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := star.X.(*ast.Ident)
+	return ok && ident.Name == structName
+}
+
+// writeVariant writes a <Name>WithContext method to buf which delegates to fn using a context-bound client
+func writeVariant(buf *bytes.Buffer, fset *token.FileSet, fn *ast.FuncDecl) error {
+	name := fn.Name.Name
+	ctxName := name + "WithContext"
+
+	params := []string{"ctx context.Context"}
+	var args []string
+	for _, field := range fn.Type.Params.List {
+		typ, err := nodeString(fset, field.Type)
+		if err != nil {
+			return err
+		}
+		_, variadic := field.Type.(*ast.Ellipsis)
+		for _, n := range field.Names {
+			params = append(params, n.Name+" "+typ)
+			if variadic {
+				args = append(args, n.Name+"...")
+			} else {
+				args = append(args, n.Name)
+			}
+		}
+	}
+	results := ""
+	if fn.Type.Results != nil {
+		var types []string
+		for _, field := range fn.Type.Results.List {
+			typ, err := nodeString(fset, field.Type)
+			if err != nil {
+				return err
+			}
+			if len(field.Names) != 0 {
+				return fmt.Errorf("named results are not supported")
+			}
+			types = append(types, typ)
+		}
+		if len(types) == 1 {
+			results = " " + types[0]
+		} else {
+			results = " (" + strings.Join(types, ", ") + ")"
+		}
+	}
+
+	buf.WriteString("\n")
+	buf.WriteString(variantDoc(fn.Doc, name, ctxName))
+	fmt.Fprintf(buf, "func (s *%s) %s(%s)%s {\n", options.structName, ctxName, strings.Join(params, ", "), results)
+	call := fmt.Sprintf("s.withContext(ctx).%s(%s)", name, strings.Join(args, ", "))
+	if results == "" {
+		fmt.Fprintf(buf, "\t%s\n}\n", call)
+	} else {
+		fmt.Fprintf(buf, "\treturn %s\n}\n", call)
+	}
+	return nil
+}
+
+// variantDoc rewrites the doc comment of the original method to describe the context variant
+func variantDoc(doc *ast.CommentGroup, name string, ctxName string) string {
+	if doc == nil || len(doc.List) != 1 || !strings.HasPrefix(doc.List[0].Text, "/*") {
+		return fmt.Sprintf("// %s - %s bound to ctx\n", ctxName, name)
+	}
+	lines := strings.Split(doc.List[0].Text, "\n")
+	var out []string
+	documented := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if i == 1 && strings.HasPrefix(trimmed, name+" ") {
+			line = strings.Replace(line, name, ctxName, 1)
+		}
+		out = append(out, line)
+		if trimmed == "Parameters:" {
+			indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+			out = append(out, indent+"\t"+ctxParamDoc)
+			documented = true
+		}
+	}
+	if !documented && len(out) > 1 {
+		indent := "\t"
+		out = append(out[:len(out)-1], indent+"Parameters:", indent+"\t"+ctxParamDoc, out[len(out)-1])
+	}
+	return strings.Join(out, "\n") + "\n"
+}
+
+func nodeString(fset *token.FileSet, node interface{}) (string, error) {
+	var b bytes.Buffer
+	if err := printer.Fprint(&b, fset, node); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
@@ -4,11 +4,19 @@ package main
 
 import (
 	"bufio"
+	"bytes"
 	"flag"
 	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -32,6 +40,7 @@ var options struct {
 	pkgName      string
 	outputFile   string
 	comment      string
+	mock         bool
 }
 
 // Prints an error message and exits.
@@ -50,10 +59,19 @@ func init() {
 	flag.StringVar(&options.icomment, "ic", "Servicer represents the interface for implementing all endpoints for this service", "name of generated interface")
 	flag.StringVar(&options.pkgName, "p", "", "package name of generated interface")
 	flag.StringVar(&options.outputFile, "o", "", "output file name. Print to stdout if not provided")
+	flag.BoolVar(&options.mock, "mock", false, "generate a mock implementation of the interface in mock_generated.go from interface.go and interface_generated.go")
 	flag.Parse()
 }
 
 func main() {
+	if options.mock {
+		file := filepath.Join(options.service, "mock_generated.go")
+		fmt.Printf("generating %s\n", file)
+		if err := generateMock(file); err != nil {
+			fatal("%v", err)
+		}
+		return
+	}
 	var err error
 	outFile := filepath.Join(options.service, "interface.go")
 	args := []string{}
@@ -143,3 +161,282 @@ func addLicense(filepath string) error {
 	file.Close()
 	return nil
 }
+
+// sdkUtilPath is the import path of the sdk util package, which is imported as sdkutil by mocks since service
+// packages import github.com/splunk/go-dependencies/util as util
+const sdkUtilPath = "github.com/splunk/splunk-cloud-sdk-go/util"
+
+const mockHeader = `/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Code generated by gen_interface.go. DO NOT EDIT.
+
+`
+
+// mockFile holds the parsed interface files of a service
+type mockFile struct {
+	fset       *token.FileSet
+	interfaces map[string]*ast.InterfaceType
+	// imports maps the canonical name of each package referred to by the interfaces to its path
+	imports map[string]string
+	// used are the canonical names of the packages referred to by the mocked methods
+	used map[string]bool
+}
+
+// generateMock writes a mock implementation of options.iface, including the methods of embedded interfaces,
+// to file
+func generateMock(file string) error {
+	mf := &mockFile{
+		fset:       token.NewFileSet(),
+		interfaces: map[string]*ast.InterfaceType{},
+		imports:    map[string]string{},
+		used:       map[string]bool{},
+	}
+	pkgName := options.pkgName
+	for _, name := range []string{"interface.go", "interface_generated.go"} {
+		f, err := parser.ParseFile(mf.fset, filepath.Join(options.service, name), nil, 0)
+		if err != nil {
+			return err
+		}
+		if pkgName == "" {
+			pkgName = f.Name.Name
+		}
+		mf.addFile(f)
+	}
+	methods, err := mf.methods(options.iface)
+	if err != nil {
+		return err
+	}
+
+	mockName := "Mock" + options.structName
+	var body bytes.Buffer
+	fmt.Fprintf(&body, "// %s is a mock implementation of %s for unit tests. Set the <Method>Func field to stub a method,\n", mockName, options.iface)
+	fmt.Fprintf(&body, "// methods which are not stubbed return zero values and an error wrapping sdkutil.ErrNotStubbed. Calls are\n")
+	fmt.Fprintf(&body, "// recorded by the embedded sdkutil.Mock for assertions, e.g. mock.AssertCalled(t, \"<Method>\", args...).\n")
+	fmt.Fprintf(&body, "type %s struct {\n\tsdkutil.Mock\n", mockName)
+	for _, method := range methods {
+		name := method.Names[0].Name
+		fmt.Fprintf(&body, "\t// %sFunc stubs %s\n\t%sFunc %s\n", name, name, name, mf.print(method.Type))
+	}
+	fmt.Fprintf(&body, "}\n\nvar _ %s = (*%s)(nil)\n", options.iface, mockName)
+	for _, method := range methods {
+		mf.writeMethod(&body, mockName, method)
+	}
+	mf.used["sdkutil"] = true
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "%spackage %s\n\n", mockHeader, pkgName)
+	out.WriteString(mf.importDecl())
+	out.Write(body.Bytes())
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting %s: %v", file, err)
+	}
+	return os.WriteFile(file, src, 0644)
+}
+
+// addFile adds the interfaces declared in f, renaming the packages they refer to with canonical names
+func (mf *mockFile) addFile(f *ast.File) {
+	names := map[string]string{}
+	for _, spec := range f.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		name := filepath.Base(path)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		canonical := filepath.Base(path)
+		if path == sdkUtilPath {
+			canonical = "sdkutil"
+		}
+		names[name] = canonical
+		mf.imports[canonical] = path
+	}
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			if x, ok := n.X.(*ast.Ident); ok {
+				if canonical, ok := names[x.Name]; ok {
+					x.Name = canonical
+				}
+			}
+		case *ast.TypeSpec:
+			if iface, ok := n.Type.(*ast.InterfaceType); ok {
+				mf.interfaces[n.Name.Name] = iface
+			}
+		}
+		return true
+	})
+}
+
+// methods returns the methods of the named interface, expanding embedded interfaces in place
+func (mf *mockFile) methods(name string) ([]*ast.Field, error) {
+	iface, ok := mf.interfaces[name]
+	if !ok {
+		return nil, fmt.Errorf("interface %s not found in %s", name, options.service)
+	}
+	var methods []*ast.Field
+	for _, field := range iface.Methods.List {
+		if len(field.Names) > 0 {
+			methods = append(methods, field)
+			continue
+		}
+		embedded, ok := field.Type.(*ast.Ident)
+		if !ok {
+			return nil, fmt.Errorf("unsupported embedded interface %s in %s", mf.print(field.Type), name)
+		}
+		more, err := mf.methods(embedded.Name)
+		if err != nil {
+			return nil, err
+		}
+		methods = append(methods, more...)
+	}
+	return methods, nil
+}
+
+// writeMethod writes the mock implementation of method
+func (mf *mockFile) writeMethod(w *bytes.Buffer, mockName string, method *ast.Field) {
+	name := method.Names[0].Name
+	ft := method.Type.(*ast.FuncType)
+	var args, callArgs []string
+	for i, param := range ft.Params.List {
+		if len(param.Names) == 0 {
+			param.Names = []*ast.Ident{ast.NewIdent(fmt.Sprintf("p%d", i))}
+		}
+		for _, n := range param.Names {
+			args = append(args, n.Name)
+			if _, ok := param.Type.(*ast.Ellipsis); ok {
+				callArgs = append(callArgs, n.Name+"...")
+			} else {
+				callArgs = append(callArgs, n.Name)
+			}
+		}
+	}
+	var zeros []string
+	if ft.Results != nil {
+		for _, result := range ft.Results.List {
+			for i := 0; i < len(result.Names) || i == 0 && len(result.Names) == 0; i++ {
+				zeros = append(zeros, mf.zero(result.Type, name))
+			}
+		}
+	}
+	recordArgs := strings.Join(append([]string{strconv.Quote(name)}, args...), ", ")
+	fmt.Fprintf(w, "\n// %s calls %sFunc if set\n", name, name)
+	fmt.Fprintf(w, "func (m *%s) %s%s {\n", mockName, name, strings.TrimPrefix(mf.print(ft), "func"))
+	fmt.Fprintf(w, "\tm.Record(%s)\n", recordArgs)
+	if len(zeros) == 0 {
+		fmt.Fprintf(w, "\tif m.%sFunc != nil {\n\t\tm.%sFunc(%s)\n\t}\n}\n", name, name, strings.Join(callArgs, ", "))
+		return
+	}
+	fmt.Fprintf(w, "\tif m.%sFunc == nil {\n\t\treturn %s\n\t}\n", name, strings.Join(zeros, ", "))
+	fmt.Fprintf(w, "\treturn m.%sFunc(%s)\n}\n", name, strings.Join(callArgs, ", "))
+}
+
+// zero returns the value of typ returned by an unstubbed method: its NotStubbed error for errors, a pager
+// failing with it for pagers and the zero value otherwise
+func (mf *mockFile) zero(typ ast.Expr, method string) string {
+	if item, ok := pagerItem(typ); ok {
+		// a pager whose first page fails rather than a nil pager
+		mf.used["context"] = true
+		mf.imports["context"] = "context"
+		return fmt.Sprintf("sdkutil.NewPager(func(context.Context, string) ([]%s, string, error) { return nil, \"\", m.NotStubbed(%q) })", mf.print(item), method)
+	}
+	switch t := typ.(type) {
+	case *ast.StarExpr, *ast.ArrayType, *ast.MapType, *ast.InterfaceType, *ast.FuncType, *ast.ChanType:
+		return "nil"
+	case *ast.Ident:
+		switch t.Name {
+		case "error":
+			return fmt.Sprintf("m.NotStubbed(%q)", method)
+		case "string":
+			return `""`
+		case "bool":
+			return "false"
+		case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "float32", "float64", "byte", "rune":
+			return "0"
+		}
+	}
+	return "*new(" + mf.print(typ) + ")"
+}
+
+// pagerItem returns the item type of a *sdkutil.Pager type
+func pagerItem(typ ast.Expr) (ast.Expr, bool) {
+	star, ok := typ.(*ast.StarExpr)
+	if !ok {
+		return nil, false
+	}
+	index, ok := star.X.(*ast.IndexExpr)
+	if !ok {
+		return nil, false
+	}
+	sel, ok := index.X.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Pager" {
+		return nil, false
+	}
+	if x, ok := sel.X.(*ast.Ident); !ok || x.Name != "sdkutil" {
+		return nil, false
+	}
+	return index.Index, true
+}
+
+// print returns the source of node, noting the packages it refers to
+func (mf *mockFile) print(node ast.Node) string {
+	ast.Inspect(node, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if x, ok := sel.X.(*ast.Ident); ok {
+				mf.used[x.Name] = true
+			}
+		}
+		return true
+	})
+	var buf bytes.Buffer
+	_ = printer.Fprint(&buf, mf.fset, node)
+	return buf.String()
+}
+
+// importDecl returns the import declaration of the packages used, standard library packages first
+func (mf *mockFile) importDecl() string {
+	var std, other []string
+	for name := range mf.used {
+		path, ok := mf.imports[name]
+		if !ok {
+			continue
+		}
+		spec := strconv.Quote(path)
+		if filepath.Base(path) != name {
+			spec = name + " " + spec
+		}
+		if strings.Contains(strings.Split(path, "/")[0], ".") {
+			other = append(other, spec)
+		} else {
+			std = append(std, spec)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(other)
+	var b strings.Builder
+	b.WriteString("import (\n")
+	for _, spec := range std {
+		b.WriteString("\t" + spec + "\n")
+	}
+	if len(std) > 0 && len(other) > 0 {
+		b.WriteString("\n")
+	}
+	for _, spec := range other {
+		b.WriteString("\t" + spec + "\n")
+	}
+	b.WriteString(")\n\n")
+	return b.String()
+}
@@ -0,0 +1,333 @@
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+var options struct {
+	service     string
+	serviceFile string
+	paramFile   string
+	modelFile   string
+	structName  string
+	outputFile  string
+}
+
+const header = `/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Code generated by gen_pager.go. DO NOT EDIT.
+
+package %s
+
+import (
+	"context"
+
+	sdkutil "github.com/splunk/splunk-cloud-sdk-go/util"
+)
+`
+
+// itemFields are the fields of list responses holding the items of a page, in order of preference
+var itemFields = []string{"Items", "Data", "Results"}
+
+// countFields are the query parameters limiting the number of items of a page for offset paging
+var countFields = []string{"Count", "Limit", "PageSize"}
+
+// Prints an error message and exits.
+func fatal(msg string, args ...interface{}) {
+	msg = fmt.Sprintf(msg, args...)
+	fmt.Fprintf(os.Stderr, "error: %s\n", msg)
+	os.Exit(1)
+}
+
+// Set up flags
+func init() {
+	flag.StringVar(&options.service, "svc", "", "service name")
+	flag.StringVar(&options.serviceFile, "sf", "service_generated.go", "service file containing the list operations")
+	flag.StringVar(&options.paramFile, "pf", "param_generated.go", "file containing the query parameter structs")
+	flag.StringVar(&options.modelFile, "mf", "model_generated.go", "file containing the response models")
+	flag.StringVar(&options.structName, "s", "Service", "struct to generate pagers for")
+	flag.StringVar(&options.outputFile, "o", "pager_generated.go", "output file name, relative to the service directory")
+	flag.Parse()
+}
+
+// listOp describes a list operation and how its pages are followed
+type listOp struct {
+	name string
+	// params and args of the operation, excluding the optional *http.Response
+	params []string
+	args   []string
+	// query is the name of the query parameters argument, if any
+	query     string
+	queryType string
+	// item is the type of the items, itemsExpr the expression of the items of the response rb
+	item      string
+	itemsExpr string
+	// pageToken is the query field and key of the page token for token paging
+	pageToken    string
+	pageTokenKey string
+	nextLink     string
+	// offset and count are the query fields for offset paging, offsetType their type
+	offset     string
+	count      string
+	offsetType string
+	total      string
+}
+
+func main() {
+	fset := token.NewFileSet()
+	parse := func(name string) *ast.File {
+		file := filepath.Join(options.service, name)
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			fatal("%v", err)
+		}
+		return f
+	}
+	svc := parse(options.serviceFile)
+	if svc == nil {
+		fatal("service file %s not found", options.serviceFile)
+	}
+	types := map[string]ast.Expr{}
+	for _, f := range []*ast.File{parse(options.paramFile), parse(options.modelFile)} {
+		if f == nil {
+			continue
+		}
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				types[ts.Name.Name] = ts.Type
+			}
+		}
+	}
+	fmt.Printf("generating pagers from %s\n", filepath.Join(options.service, options.serviceFile))
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, header, svc.Name.Name)
+	for _, decl := range svc.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || !strings.HasPrefix(fn.Name.Name, "List") || !isReceiver(fn, options.structName) {
+			continue
+		}
+		op, ok := newListOp(fset, fn, types)
+		if !ok {
+			continue
+		}
+		writePager(&buf, op)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		fatal("%v", err)
+	}
+	out := filepath.Join(options.service, options.outputFile)
+	if err := os.WriteFile(out, src, 0644); err != nil {
+		fatal("%v", err)
+	}
+}
+
+// newListOp describes fn, returning false if its response has no list of items
+func newListOp(fset *token.FileSet, fn *ast.FuncDecl, types map[string]ast.Expr) (*listOp, bool) {
+	op := &listOp{name: fn.Name.Name}
+	for _, field := range fn.Type.Params.List {
+		if _, variadic := field.Type.(*ast.Ellipsis); variadic {
+			continue
+		}
+		typ := nodeString(fset, field.Type)
+		for _, n := range field.Names {
+			op.params = append(op.params, n.Name+" "+typ)
+			op.args = append(op.args, n.Name)
+			if strings.HasSuffix(typ, "QueryParams") {
+				op.query, op.queryType = n.Name, strings.TrimPrefix(typ, "*")
+			}
+		}
+	}
+	if fn.Type.Results == nil || len(fn.Type.Results.List) != 2 {
+		return nil, false
+	}
+	var response *ast.StructType
+	switch result := fn.Type.Results.List[0].Type.(type) {
+	case *ast.ArrayType:
+		op.item, op.itemsExpr = nodeString(fset, result.Elt), "rb"
+	case *ast.StarExpr:
+		ident, ok := result.X.(*ast.Ident)
+		if !ok {
+			return nil, false
+		}
+		switch model := types[ident.Name].(type) {
+		case *ast.ArrayType:
+			op.item, op.itemsExpr = nodeString(fset, model.Elt), "*rb"
+		case *ast.StructType:
+			response = model
+			for _, name := range itemFields {
+				if f := findField(model, name); f != nil {
+					if arr, ok := f.Type.(*ast.ArrayType); ok {
+						op.item, op.itemsExpr = nodeString(fset, arr.Elt), "rb."+name
+						break
+					}
+				}
+			}
+		}
+	}
+	if op.item == "" {
+		return nil, false
+	}
+	query, _ := types[op.queryType].(*ast.StructType)
+	if query == nil {
+		return op, true
+	}
+	if f := findField(query, "PageToken"); f != nil && response != nil {
+		if link := findField(response, "NextLink"); link != nil && nodeString(fset, link.Type) == "string" {
+			op.pageToken, op.pageTokenKey, op.nextLink = "PageToken", tagKey(f), "rb.NextLink"
+			return op, true
+		}
+	}
+	if f := findField(query, "Offset"); f != nil {
+		for _, name := range countFields {
+			if c := findField(query, name); c != nil && nodeString(fset, c.Type) == nodeString(fset, f.Type) {
+				op.offset, op.count = "Offset", name
+				op.offsetType = strings.TrimPrefix(nodeString(fset, f.Type), "*")
+				break
+			}
+		}
+		if response != nil {
+			if t := findField(response, "Total"); t != nil && nodeString(fset, t.Type) == "*int64" {
+				op.total = "rb.Total"
+			}
+		}
+	}
+	return op, true
+}
+
+// writePager writes the <Name>Pages and <Name>All methods of op to buf
+func writePager(buf *bytes.Buffer, op *listOp) {
+	params := strings.Join(op.params, ", ")
+	args := strings.Join(op.args, ", ")
+	callArgs := op.args
+	if op.query != "" {
+		callArgs = make([]string, len(op.args))
+		for i, a := range op.args {
+			callArgs[i] = a
+			if a == op.query {
+				callArgs[i] = "&q"
+			}
+		}
+	}
+	call := fmt.Sprintf("s.%sWithContext(%s)", op.name, strings.Join(append([]string{"ctx"}, callArgs...), ", "))
+	s := options.structName
+
+	fmt.Fprintf(buf, "\n// %sPages returns a Pager over the pages of %s", op.name, op.name)
+	switch {
+	case op.pageToken != "":
+		buf.WriteString(", following the nextLink of each page\n")
+	case op.offset != "":
+		fmt.Fprintf(buf, ", advancing the offset by the number of items of each page\n")
+	default:
+		buf.WriteString(", which returns a single page\n")
+	}
+	fmt.Fprintf(buf, "func (s *%s) %sPages(%s) *sdkutil.Pager[%s] {\n", s, op.name, params, op.item)
+	if op.query != "" {
+		fmt.Fprintf(buf, "\tvar q %s\n\tif %s != nil {\n\t\tq = *%s\n\t}\n", op.queryType, op.query, op.query)
+	}
+	switch {
+	case op.pageToken != "":
+		fmt.Fprintf(buf, "\treturn sdkutil.NewPager(func(ctx context.Context, cursor string) ([]%s, string, error) {\n", op.item)
+		fmt.Fprintf(buf, "\t\tif cursor != \"\" {\n\t\t\tq.%s = cursor\n\t\t}\n", op.pageToken)
+		fmt.Fprintf(buf, "\t\trb, err := %s\n\t\tif err != nil {\n\t\t\treturn nil, \"\", err\n\t\t}\n", call)
+		fmt.Fprintf(buf, "\t\treturn %s, sdkutil.PageTokenFromLink(%s, %q), nil\n\t})\n}\n", op.itemsExpr, op.nextLink, op.pageTokenKey)
+	case op.offset != "":
+		total := "nil"
+		if op.total != "" {
+			total = op.total
+		}
+		fmt.Fprintf(buf, "\treturn sdkutil.NewOffsetPager(q.%s, q.%s, func(ctx context.Context, offset %s) ([]%s, *int64, error) {\n", op.offset, op.count, op.offsetType, op.item)
+		fmt.Fprintf(buf, "\t\tq.%s = &offset\n", op.offset)
+		fmt.Fprintf(buf, "\t\trb, err := %s\n\t\tif err != nil {\n\t\t\treturn nil, nil, err\n\t\t}\n", call)
+		fmt.Fprintf(buf, "\t\treturn %s, %s, nil\n\t})\n}\n", op.itemsExpr, total)
+	default:
+		fmt.Fprintf(buf, "\treturn sdkutil.NewPager(func(ctx context.Context, _ string) ([]%s, string, error) {\n", op.item)
+		fmt.Fprintf(buf, "\t\trb, err := %s\n\t\tif err != nil {\n\t\t\treturn nil, \"\", err\n\t\t}\n", call)
+		fmt.Fprintf(buf, "\t\treturn %s, \"\", nil\n\t})\n}\n", op.itemsExpr)
+	}
+
+	fmt.Fprintf(buf, "\n// %sAll returns the items of all pages of %s\n", op.name, op.name)
+	fmt.Fprintf(buf, "func (s *%s) %sAll(%s) ([]%s, error) {\n", s, op.name, params, op.item)
+	fmt.Fprintf(buf, "\treturn s.%sPages(%s).All(context.Background())\n}\n", op.name, args)
+
+	ctxParams := strings.Join(append([]string{"ctx context.Context"}, op.params...), ", ")
+	fmt.Fprintf(buf, "\n// %sAllWithContext - %sAll bound to ctx\n", op.name, op.name)
+	fmt.Fprintf(buf, "func (s *%s) %sAllWithContext(%s) ([]%s, error) {\n", s, op.name, ctxParams, op.item)
+	fmt.Fprintf(buf, "\treturn s.%sPages(%s).All(ctx)\n}\n", op.name, args)
+}
+
+// findField returns the field of st with the given name, or nil
+func findField(st *ast.StructType, name string) *ast.Field {
+	for _, f := range st.Fields.List {
+		for _, n := range f.Names {
+			if n.Name == name {
+				return f
+			}
+		}
+	}
+	return nil
+}
+
+// tagKey returns the query parameter key of a query parameters field
+func tagKey(f *ast.Field) string {
+	if f.Tag == nil {
+		return ""
+	}
+	return reflect.StructTag(strings.Trim(f.Tag.Value, "`")).Get("key")
+}
+
+// isReceiver returns whether fn is a method with a *structName receiver
+func isReceiver(fn *ast.FuncDecl, structName string) bool {
+	if fn.Recv == nil || len(fn.Recv.List) != 1 {
+		return false
+	}
+	star, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := star.X.(*ast.Ident)
+	return ok && ident.Name == structName
+}
+
+func nodeString(fset *token.FileSet, node interface{}) string {
+	var b bytes.Buffer
+	if err := printer.Fprint(&b, fset, node); err != nil {
+		fatal("%v", err)
+	}
+	return b.String()
+}
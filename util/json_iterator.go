@@ -0,0 +1,188 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package util
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// JSONIterator decodes values of type T one at a time from a stream, such that large responses can be
+// processed without holding them in memory. The stream may be either a JSON array of values, optionally
+// nested within objects, or newline delimited JSON (NDJSON). Use Next() to advance through the values:
+//
+//	records := util.NewJSONIterator[map[string]interface{}](response.Body)
+//	defer records.Close()
+//	for records.Next() {
+//		record := records.Value()
+//		...
+//	}
+//	err := records.Err() // get any error encountered during iteration
+type JSONIterator[T any] struct {
+	body    io.ReadCloser
+	reader  *bufio.Reader
+	decoder *json.Decoder
+	path    []string
+	array   bool
+	started bool
+	done    bool
+	value   T
+	err     error
+}
+
+// NewJSONIterator creates an iterator over the values of body, which is decoded as a JSON array if it
+// starts with `[` and as NDJSON otherwise
+func NewJSONIterator[T any](body io.ReadCloser) *JSONIterator[T] {
+	it := newJSONIterator[T](body)
+	it.array = it.peek() == '['
+	return it
+}
+
+// NewJSONArrayIterator creates an iterator over the values of the JSON array in body. If path is specified
+// the array is found by following the fields of path from the top level object, e.g. with path "results"
+// the values of {"results": [...]} are returned
+func NewJSONArrayIterator[T any](body io.ReadCloser, path ...string) *JSONIterator[T] {
+	it := newJSONIterator[T](body)
+	it.array = true
+	it.path = path
+	return it
+}
+
+// NewNDJSONIterator creates an iterator over the newline delimited JSON values of body
+func NewNDJSONIterator[T any](body io.ReadCloser) *JSONIterator[T] {
+	return newJSONIterator[T](body)
+}
+
+func newJSONIterator[T any](body io.ReadCloser) *JSONIterator[T] {
+	reader := bufio.NewReader(body)
+	return &JSONIterator[T]{body: body, reader: reader, decoder: json.NewDecoder(reader)}
+}
+
+// peek returns the first non-whitespace byte of the stream, or 0 if it cannot be read
+func (it *JSONIterator[T]) peek() byte {
+	for {
+		b, err := it.reader.Peek(1)
+		if err != nil {
+			return 0
+		}
+		switch b[0] {
+		case ' ', '\t', '\r', '\n':
+			it.reader.ReadByte()
+		default:
+			return b[0]
+		}
+	}
+}
+
+// Next decodes the next value for reading with the Value method. It returns true on success, or false if
+// there are no more values or an error occurred while decoding.
+//
+// Every call to Value, even the first one, must be preceded by a call to Next.
+func (it *JSONIterator[T]) Next() bool {
+	if it.done || it.err != nil {
+		return false
+	}
+	if !it.started {
+		it.started = true
+		if it.array {
+			found, err := it.openArray()
+			if err != nil || !found {
+				it.err = err
+				it.done = true
+				return false
+			}
+		}
+	}
+	if it.array && !it.decoder.More() {
+		it.done = true
+		return false
+	}
+	var value T
+	if err := it.decoder.Decode(&value); err != nil {
+		if !it.array && errors.Is(err, io.EOF) {
+			it.done = true
+			return false
+		}
+		it.err = err
+		return false
+	}
+	it.value = value
+	return true
+}
+
+// openArray advances the decoder to the first value of the array at the iterator's path, returning false if
+// the path is not found
+func (it *JSONIterator[T]) openArray() (bool, error) {
+	for _, field := range it.path {
+		if err := it.expectDelim('{'); err != nil {
+			return false, err
+		}
+		found := false
+		for it.decoder.More() {
+			token, err := it.decoder.Token()
+			if err != nil {
+				return false, err
+			}
+			if token == field {
+				found = true
+				break
+			}
+			// skip the value of other fields
+			var skipped json.RawMessage
+			if err := it.decoder.Decode(&skipped); err != nil {
+				return false, err
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+	if err := it.expectDelim('['); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (it *JSONIterator[T]) expectDelim(delim json.Delim) error {
+	token, err := it.decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("expected %q in JSON stream but found %v", delim, token)
+	}
+	return nil
+}
+
+// Value returns the value decoded by the last call to Next
+func (it *JSONIterator[T]) Value() T {
+	return it.value
+}
+
+// Err returns the error encountered during iteration, if any
+func (it *JSONIterator[T]) Err() error {
+	return it.err
+}
+
+// Close closes the underlying stream, after Close no more values are returned
+func (it *JSONIterator[T]) Close() error {
+	it.done = true
+	return it.body.Close()
+}
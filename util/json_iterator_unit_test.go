@@ -0,0 +1,87 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package util
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type row struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// closeRecorder records whether the body was closed
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (c *closeRecorder) Close() error {
+	c.closed = true
+	return nil
+}
+
+func collect[T any](t *testing.T, it *JSONIterator[T]) []T {
+	var values []T
+	for it.Next() {
+		values = append(values, it.Value())
+	}
+	require.NoError(t, it.Err())
+	return values
+}
+
+func TestJSONIteratorArray(t *testing.T) {
+	body := &closeRecorder{Reader: strings.NewReader(` [{"id":1,"name":"a"}, {"id":2,"name":"b"}] `)}
+	it := NewJSONIterator[row](body)
+	assert.Equal(t, []row{{1, "a"}, {2, "b"}}, collect(t, it))
+	assert.False(t, it.Next())
+	require.NoError(t, it.Close())
+	assert.True(t, body.closed)
+
+	it = NewJSONIterator[row](io.NopCloser(strings.NewReader(`[]`)))
+	assert.Empty(t, collect(t, it))
+}
+
+func TestJSONIteratorNDJSON(t *testing.T) {
+	body := io.NopCloser(strings.NewReader("{\"id\":1,\"name\":\"a\"}\n{\"id\":2,\"name\":\"b\"}\n\n{\"id\":3,\"name\":\"c\"}\n"))
+	assert.Equal(t, []row{{1, "a"}, {2, "b"}, {3, "c"}}, collect(t, NewJSONIterator[row](body)))
+
+	body = io.NopCloser(strings.NewReader("{\"id\":1}\n[\"not a row\"]\n"))
+	it := NewNDJSONIterator[row](body)
+	assert.True(t, it.Next())
+	assert.False(t, it.Next())
+	assert.Error(t, it.Err())
+}
+
+func TestJSONArrayIteratorPath(t *testing.T) {
+	body := io.NopCloser(strings.NewReader(`{"preview":false,"meta":{"results":[0]},"data":{"results":[{"id":1},{"id":2}],"more":true}}`))
+	assert.Equal(t, []row{{ID: 1}, {ID: 2}}, collect(t, NewJSONArrayIterator[row](body, "data", "results")))
+
+	body = io.NopCloser(strings.NewReader(`{"other":[{"id":1}]}`))
+	assert.Empty(t, collect(t, NewJSONArrayIterator[row](body, "results")), "a missing path has no values")
+
+	body = io.NopCloser(strings.NewReader(`{"results":{"id":1}}`))
+	it := NewJSONArrayIterator[row](body, "results")
+	assert.False(t, it.Next())
+	assert.Error(t, it.Err())
+}
@@ -0,0 +1,348 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package util
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Redacted replaces the values of credentials in log records
+const Redacted = "REDACTED"
+
+const defaultMaxBodySize = 4096
+
+// redactedHeaders are always redacted
+var redactedHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// redactedFields are the names of JSON fields, form fields and query parameters which are always redacted, authorization
+// codes are also redacted from form fields and query parameters
+var redactedFields = map[string]bool{
+	"access_token":  true,
+	"refresh_token": true,
+	"id_token":      true,
+	"token":         true,
+	"password":      true,
+	"client_secret": true,
+	"code_verifier": true,
+	"device_code":   true,
+}
+
+// LogTransportOptions configures a LogTransport, all fields are optional
+type LogTransportOptions struct {
+	// Level is the level of request and response records, slog.LevelDebug by default
+	Level slog.Leveler
+	// ErrorLevel is the level of records for requests which fail or receive a response with status >= 400,
+	// slog.LevelWarn by default
+	ErrorLevel slog.Leveler
+	// MaxBodySize is the number of bytes of request and response bodies logged, longer bodies are truncated.
+	// Default to 4096, bodies are not logged if negative
+	MaxBodySize int
+	// RedactHeaders are the names of headers to redact in addition to Authorization, Proxy-Authorization,
+	// Cookie and Set-Cookie
+	RedactHeaders []string
+	// RedactJSONPaths are dot-separated paths of fields to redact from JSON bodies, e.g. "secrets.key"
+	// redacts {"secrets": {"key": "..."}} - token, password and secret fields are always redacted
+	RedactJSONPaths []string
+}
+
+// LogTransport is a RoundTripper which logs requests and responses as structured log/slog records,
+// credentials such as the Authorization header and token fields of bodies are redacted
+type LogTransport struct {
+	transport      http.RoundTripper
+	logger         *slog.Logger
+	level          slog.Leveler
+	errorLevel     slog.Leveler
+	maxBodySize    int
+	redactHeaders  []string
+	redactJSONPath map[string]bool
+	// partialJSONFields matches the fields to redact from truncated JSON bodies
+	partialJSONFields *regexp.Regexp
+}
+
+// NewLogTransport creates a LogTransport which logs to logger and sends requests using transport, or
+// http.DefaultTransport if nil
+func NewLogTransport(logger *slog.Logger, transport http.RoundTripper, options LogTransportOptions) *LogTransport {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	lt := &LogTransport{
+		transport:         transport,
+		logger:            logger,
+		level:             options.Level,
+		errorLevel:        options.ErrorLevel,
+		maxBodySize:       options.MaxBodySize,
+		redactHeaders:     append(append([]string{}, redactedHeaders...), options.RedactHeaders...),
+		redactJSONPath:    make(map[string]bool),
+		partialJSONFields: partialJSONFields(options.RedactJSONPaths),
+	}
+	if lt.level == nil {
+		lt.level = slog.LevelDebug
+	}
+	if lt.errorLevel == nil {
+		lt.errorLevel = slog.LevelWarn
+	}
+	if lt.maxBodySize == 0 {
+		lt.maxBodySize = defaultMaxBodySize
+	}
+	for _, p := range options.RedactJSONPaths {
+		lt.redactJSONPath[p] = true
+	}
+	return lt
+}
+
+type attemptCounterKey struct{}
+
+// WithAttemptCounter returns a copy of ctx holding the counter of attempts made to send a request, which
+// is logged by LogTransport
+func WithAttemptCounter(ctx context.Context, counter *uint) context.Context {
+	return context.WithValue(ctx, attemptCounterKey{}, counter)
+}
+
+// RoundTrip implements the RoundTripper interface
+func (lt *LogTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	ctx := request.Context()
+	attrs := []slog.Attr{
+		slog.String("method", request.Method),
+		slog.String("url", lt.redactURL(request.URL)),
+	}
+	if counter, ok := ctx.Value(attemptCounterKey{}).(*uint); ok && counter != nil {
+		attrs = append(attrs, slog.Uint64("attempt", uint64(*counter)))
+	}
+	if lt.logger.Enabled(ctx, lt.level.Level()) {
+		reqAttrs := append(attrs, slog.Any("headers", lt.redactHeaderValues(request.Header)))
+		if body, ok := lt.requestBody(request); ok {
+			reqAttrs = append(reqAttrs, slog.String("body", body))
+		}
+		lt.logger.LogAttrs(ctx, lt.level.Level(), "http request", reqAttrs...)
+	}
+
+	start := time.Now()
+	response, err := lt.transport.RoundTrip(request)
+	attrs = append(attrs, slog.Duration("latency", time.Since(start)))
+	if err != nil {
+		lt.logger.LogAttrs(ctx, lt.errorLevel.Level(), "http request failed", append(attrs, slog.String("error", err.Error()))...)
+		return response, err
+	}
+
+	level := lt.level.Level()
+	if response.StatusCode >= 400 {
+		level = lt.errorLevel.Level()
+	}
+	if lt.logger.Enabled(ctx, level) {
+		attrs = append(attrs,
+			slog.Int("status", response.StatusCode),
+			slog.String("request_id", response.Header.Get("X-Request-ID")),
+			slog.Any("headers", lt.redactHeaderValues(response.Header)))
+		if body, ok := lt.responseBody(response); ok {
+			attrs = append(attrs, slog.String("body", body))
+		}
+		lt.logger.LogAttrs(ctx, level, "http response", attrs...)
+	}
+	return response, nil
+}
+
+// requestBody returns the request body to log, reading only the bytes logged without consuming the body sent
+func (lt *LogTransport) requestBody(request *http.Request) (string, bool) {
+	if lt.maxBodySize < 0 || request.Body == nil || request.Body == http.NoBody {
+		return "", false
+	}
+	if request.ContentLength <= 0 {
+		// the body is streamed as it is sent, reading it here could interfere with sending it
+		return "<streamed body>", true
+	}
+	var head []byte
+	var err error
+	if request.GetBody != nil {
+		var body io.ReadCloser
+		if body, err = request.GetBody(); err == nil {
+			head, err = lt.readHead(body)
+			body.Close()
+		}
+	} else {
+		head, err = lt.readHead(request.Body)
+		request.Body = prefixedBody{Reader: io.MultiReader(bytes.NewReader(head), request.Body), Closer: request.Body}
+	}
+	if err != nil {
+		return "", false
+	}
+	return lt.formatHead(head, request.Header), true
+}
+
+// prefixedBody is a request or response body whose first bytes have been read for logging
+type prefixedBody struct {
+	io.Reader
+	io.Closer
+}
+
+// responseBody returns the response body to log, reading only the bytes logged and replacing the body such that
+// it can still be read in full
+func (lt *LogTransport) responseBody(response *http.Response) (string, bool) {
+	if lt.maxBodySize < 0 || response.Body == nil || response.Body == http.NoBody {
+		return "", false
+	}
+	head, err := lt.readHead(response.Body)
+	response.Body = prefixedBody{Reader: io.MultiReader(bytes.NewReader(head), response.Body), Closer: response.Body}
+	if err != nil {
+		return "", false
+	}
+	return lt.formatHead(head, response.Header), true
+}
+
+// readHead reads the first MaxBodySize bytes of body, and one more to tell whether the body is truncated
+func (lt *LogTransport) readHead(body io.Reader) ([]byte, error) {
+	return io.ReadAll(io.LimitReader(body, int64(lt.maxBodySize)+1))
+}
+
+// formatHead formats the first bytes of a body read by readHead
+func (lt *LogTransport) formatHead(head []byte, header http.Header) string {
+	if len(head) > lt.maxBodySize {
+		return lt.formatPartialBody(head[:lt.maxBodySize], header, true)
+	}
+	return lt.formatBody(head, header)
+}
+
+// formatBody decompresses, redacts and truncates a body
+func (lt *LogTransport) formatBody(content []byte, header http.Header) string {
+	return lt.formatPartialBody(content, header, false)
+}
+
+// formatPartialBody decompresses, redacts and truncates a body, of which only content has been read if partial
+func (lt *LogTransport) formatPartialBody(content []byte, header http.Header, partial bool) string {
+	if header.Get("Content-Encoding") == "gzip" {
+		zr, err := gzip.NewReader(bytes.NewReader(content))
+		if err != nil {
+			return "<gzip body>"
+		}
+		// a partial body decompresses until the data which has not been read
+		if content, err = io.ReadAll(zr); err != nil && !partial {
+			return "<gzip body>"
+		}
+	}
+	contentType := header.Get("Content-Type")
+	switch {
+	case strings.HasPrefix(contentType, "application/x-www-form-urlencoded"):
+		if values, err := url.ParseQuery(string(content)); err == nil {
+			content = []byte(redactValues(values).Encode())
+		}
+	case strings.HasPrefix(contentType, "multipart/"):
+		content = []byte("<multipart body>")
+	default:
+		var v interface{}
+		if json.Unmarshal(content, &v) == nil {
+			if redacted, err := json.Marshal(lt.redactJSON(v, "")); err == nil {
+				content = redacted
+			}
+		} else if partial {
+			// the JSON of a partial body cannot be decoded, redact credential fields by name instead
+			content = lt.redactPartialJSON(content)
+		}
+	}
+	if len(content) > lt.maxBodySize {
+		return string(content[:lt.maxBodySize]) + "...(truncated)"
+	}
+	if partial {
+		return string(content) + "...(truncated)"
+	}
+	return string(content)
+}
+
+// redactJSON redacts credential fields and the configured paths from a decoded JSON value
+func (lt *LogTransport) redactJSON(v interface{}, path string) interface{} {
+	return redactJSON(v, path, lt.redactJSONPath)
+}
+
+// redactJSON redacts credential fields and paths from a decoded JSON value
+func redactJSON(v interface{}, path string, paths map[string]bool) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for key, field := range value {
+			fieldPath := key
+			if path != "" {
+				fieldPath = path + "." + key
+			}
+			if redactedFields[strings.ToLower(key)] || strings.Contains(strings.ToLower(key), "secret") || paths[fieldPath] {
+				value[key] = Redacted
+			} else {
+				value[key] = redactJSON(field, fieldPath, paths)
+			}
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = redactJSON(item, path, paths)
+		}
+	}
+	return v
+}
+
+// partialJSONFields matches the values of credential fields, and of the last field of each of paths, in JSON
+// which cannot be decoded as it is incomplete
+func partialJSONFields(paths []string) *regexp.Regexp {
+	names := []string{`[^"]*secret[^"]*`}
+	for name := range redactedFields {
+		names = append(names, regexp.QuoteMeta(name))
+	}
+	for _, path := range paths {
+		names = append(names, regexp.QuoteMeta(path[strings.LastIndex(path, ".")+1:]))
+	}
+	return regexp.MustCompile(`(?i)("(?:` + strings.Join(names, "|") + `)"\s*:\s*)(?:"(?:[^"\\]|\\.)*(?:"|\\?$)|[^,}\]]*)`)
+}
+
+// redactPartialJSON redacts credential fields by name from JSON which cannot be decoded as it is incomplete
+func (lt *LogTransport) redactPartialJSON(content []byte) []byte {
+	return lt.partialJSONFields.ReplaceAll(content, []byte(`${1}"`+Redacted+`"`))
+}
+
+// redactHeaderValues returns a copy of headers with credentials redacted
+func (lt *LogTransport) redactHeaderValues(headers http.Header) http.Header {
+	redacted := headers.Clone()
+	for _, name := range lt.redactHeaders {
+		if _, ok := redacted[http.CanonicalHeaderKey(name)]; ok {
+			redacted.Set(name, Redacted)
+		}
+	}
+	return redacted
+}
+
+// redactURL returns the URL with credential query parameters redacted
+func (lt *LogTransport) redactURL(u *url.URL) string {
+	if u.RawQuery == "" {
+		return u.String()
+	}
+	redacted := *u
+	redacted.RawQuery = redactValues(u.Query()).Encode()
+	return redacted.String()
+}
+
+func redactValues(values url.Values) url.Values {
+	for key := range values {
+		name := strings.ToLower(key)
+		if redactedFields[name] || name == "code" || strings.Contains(name, "secret") {
+			values.Set(key, Redacted)
+		}
+	}
+	return values
+}
@@ -0,0 +1,216 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package util
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type logRT struct {
+	status int
+	body   string
+	err    error
+}
+
+func (rt *logRT) RoundTrip(req *http.Request) (*http.Response, error) {
+	if rt.err != nil {
+		return nil, rt.err
+	}
+	header := http.Header{}
+	header.Set("X-Request-ID", "req-1")
+	header.Set("Content-Type", "application/json")
+	return &http.Response{StatusCode: rt.status, Header: header, Body: io.NopCloser(strings.NewReader(rt.body))}, nil
+}
+
+// logRecords decodes the JSON records written to buf
+func logRecords(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	var records []map[string]interface{}
+	decoder := json.NewDecoder(buf)
+	for decoder.More() {
+		var record map[string]interface{}
+		require.NoError(t, decoder.Decode(&record))
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestLogTransportRedacts(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	rt := &logRT{status: 200, body: `{"access_token":"secret-token","nested":{"key":"value","other":"kept"},"code":"E1"}`}
+	lt := NewLogTransport(logger, rt, LogTransportOptions{RedactJSONPaths: []string{"nested.key"}, RedactHeaders: []string{"X-Api-Key"}})
+
+	counter := uint(2)
+	req, err := http.NewRequestWithContext(WithAttemptCounter(context.Background(), &counter), http.MethodPost,
+		"https://api.example.com/tenant/identity/v3/validate?access_token=abc&include=tenant",
+		strings.NewReader(`{"password":"hunter2","name":"me"}`))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer my.jwt.token")
+	req.Header.Set("X-Api-Key", "key")
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := lt.RoundTrip(req)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, rt.body, string(body), "the response body should still be readable")
+	sent, err := io.ReadAll(req.Body)
+	require.NoError(t, err)
+	assert.Equal(t, `{"password":"hunter2","name":"me"}`, string(sent), "the request body should still be sent")
+
+	output := buf.String()
+	for _, secret := range []string{"my.jwt.token", "hunter2", "secret-token", "abc", `"value"`, `"key"`} {
+		assert.NotContains(t, output, secret)
+	}
+	records := logRecords(t, &buf)
+	require.Len(t, records, 2)
+	request, response := records[0], records[1]
+	assert.Equal(t, "DEBUG", request["level"])
+	assert.Equal(t, "http request", request["msg"])
+	assert.Equal(t, "POST", request["method"])
+	assert.Equal(t, float64(2), request["attempt"])
+	assert.Equal(t, "https://api.example.com/tenant/identity/v3/validate?access_token=REDACTED&include=tenant", request["url"])
+	assert.Equal(t, `{"name":"me","password":"REDACTED"}`, request["body"])
+	assert.Equal(t, "http response", response["msg"])
+	assert.Equal(t, float64(200), response["status"])
+	assert.Equal(t, "req-1", response["request_id"])
+	assert.Contains(t, response, "latency")
+	assert.Equal(t, `{"access_token":"REDACTED","code":"E1","nested":{"key":"REDACTED","other":"kept"}}`, response["body"])
+}
+
+func TestLogTransportLevels(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	lt := NewLogTransport(logger, &logRT{status: 200, body: "ok"}, LogTransportOptions{})
+	req, err := http.NewRequest(http.MethodGet, "https://api.example.com/", nil)
+	require.NoError(t, err)
+	_, err = lt.RoundTrip(req)
+	require.NoError(t, err)
+	assert.Empty(t, buf.String(), "debug records should not be written")
+
+	lt = NewLogTransport(logger, &logRT{status: 404, body: strings.Repeat("x", 100)}, LogTransportOptions{MaxBodySize: 10})
+	_, err = lt.RoundTrip(req)
+	require.NoError(t, err)
+	lt = NewLogTransport(logger, &logRT{err: errors.New("connection refused")}, LogTransportOptions{})
+	_, err = lt.RoundTrip(req)
+	require.Error(t, err)
+
+	records := logRecords(t, &buf)
+	require.Len(t, records, 2)
+	assert.Equal(t, "WARN", records[0]["level"])
+	assert.Equal(t, float64(404), records[0]["status"])
+	assert.Equal(t, "xxxxxxxxxx...(truncated)", records[0]["body"])
+	assert.Equal(t, "http request failed", records[1]["msg"])
+	assert.Equal(t, "connection refused", records[1]["error"])
+}
+
+type closeTracker struct {
+	io.Reader
+	read   int
+	closed bool
+}
+
+func (c *closeTracker) Read(p []byte) (int, error) {
+	n, err := c.Reader.Read(p)
+	c.read += n
+	return n, err
+}
+
+func (c *closeTracker) Close() error {
+	c.closed = true
+	return nil
+}
+
+type bodyRT struct {
+	body io.ReadCloser
+}
+
+func (rt *bodyRT) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: 200, Header: http.Header{"Content-Type": {"application/json"}}, Body: rt.body}, nil
+}
+
+func TestLogTransportLargeResponseBody(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	content := `{"access_token":"secret-token","items":["` + strings.Repeat("x", 1<<20) + `"]}`
+	body := &closeTracker{Reader: strings.NewReader(content)}
+	lt := NewLogTransport(logger, &bodyRT{body: body}, LogTransportOptions{MaxBodySize: 64})
+	req, err := http.NewRequest(http.MethodGet, "https://api.example.com/", nil)
+	require.NoError(t, err)
+	resp, err := lt.RoundTrip(req)
+	require.NoError(t, err)
+	assert.Equal(t, 65, body.read, "only the logged part of the body should be read")
+
+	read, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, content, string(read), "the response body should still be readable in full")
+	require.NoError(t, resp.Body.Close())
+	assert.True(t, body.closed)
+
+	assert.NotContains(t, buf.String(), "secret-token")
+	records := logRecords(t, &buf)
+	require.Len(t, records, 2)
+	assert.Equal(t, `{"access_token":"REDACTED","items":["`+strings.Repeat("x", 23)+`...(truncated)`, records[1]["body"])
+}
+
+func TestLogTransportLargeRequestBody(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	lt := NewLogTransport(logger, &logRT{status: 200, body: "ok"}, LogTransportOptions{MaxBodySize: 64})
+	content := `{"password":"hunter2","events":["` + strings.Repeat("x", 1<<20) + `"]}`
+
+	// a replayable body is read from a copy
+	replayed := &closeTracker{Reader: strings.NewReader(content)}
+	req, err := http.NewRequest(http.MethodPost, "https://api.example.com/", strings.NewReader(content))
+	require.NoError(t, err)
+	req.GetBody = func() (io.ReadCloser, error) { return replayed, nil }
+	_, err = lt.RoundTrip(req)
+	require.NoError(t, err)
+	assert.Equal(t, 65, replayed.read, "only the logged part of the body should be read")
+	assert.True(t, replayed.closed)
+
+	// other bodies are replaced such that they are still sent in full
+	body := &closeTracker{Reader: strings.NewReader(content)}
+	req, err = http.NewRequest(http.MethodPost, "https://api.example.com/", body)
+	require.NoError(t, err)
+	req.ContentLength = int64(len(content))
+	_, err = lt.RoundTrip(req)
+	require.NoError(t, err)
+	assert.Equal(t, 65, body.read, "only the logged part of the body should be read")
+	sent, err := io.ReadAll(req.Body)
+	require.NoError(t, err)
+	assert.Equal(t, content, string(sent))
+	require.NoError(t, req.Body.Close())
+	assert.True(t, body.closed)
+
+	assert.NotContains(t, buf.String(), "hunter2")
+	records := logRecords(t, &buf)
+	require.Len(t, records, 4)
+	for _, record := range []map[string]interface{}{records[0], records[2]} {
+		assert.Equal(t, `{"password":"REDACTED","events":["`+strings.Repeat("x", 30)+`...(truncated)`, record["body"])
+	}
+}
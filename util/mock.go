@@ -0,0 +1,166 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package util
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// ErrNotStubbed is wrapped by the errors returned by mock methods which are not stubbed
+var ErrNotStubbed = errors.New("mock method not stubbed")
+
+// ArgMatcher matches an argument of a recorded call in place of an expected value in Mock assertions
+type ArgMatcher func(arg interface{}) bool
+
+// Anything matches any argument in Mock assertions, e.g. the context of a WithContext method
+var Anything = ArgMatcher(func(interface{}) bool { return true })
+
+// TestingT is the subset of testing.TB used by Mock assertions
+type TestingT interface {
+	Errorf(format string, args ...interface{})
+}
+
+// MockCall is a call to a mock method with its arguments, variadic arguments are recorded as a slice
+type MockCall struct {
+	Method string
+	Args   []interface{}
+}
+
+// Mock records the calls made to a mock implementation of an interface, such as the MockService generated for
+// each service, for assertions in unit tests. The zero value is ready to use and safe for concurrent use.
+type Mock struct {
+	mux   sync.Mutex
+	calls []MockCall
+}
+
+// Record records a call to method with args
+func (m *Mock) Record(method string, args ...interface{}) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	m.calls = append(m.calls, MockCall{Method: method, Args: args})
+}
+
+// NotStubbed returns the error returned by method when it is not stubbed
+func (m *Mock) NotStubbed(method string) error {
+	return fmt.Errorf("%w: %s", ErrNotStubbed, method)
+}
+
+// Calls returns the recorded calls to method in order, or all recorded calls if method is empty
+func (m *Mock) Calls(method string) []MockCall {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	var calls []MockCall
+	for _, call := range m.calls {
+		if method == "" || call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Called returns whether method was called with args, which are compared with the leading arguments of each call
+// such that trailing arguments, e.g. the optional resp of service methods, may be omitted. Expected arguments are
+// compared with reflect.DeepEqual unless they are an ArgMatcher such as Anything.
+func (m *Mock) Called(method string, args ...interface{}) bool {
+	for _, call := range m.Calls(method) {
+		if call.matches(args) {
+			return true
+		}
+	}
+	return false
+}
+
+// Reset forgets all recorded calls
+func (m *Mock) Reset() {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	m.calls = nil
+}
+
+// AssertCalled reports an error to t unless method was called with args, see Called
+func (m *Mock) AssertCalled(t TestingT, method string, args ...interface{}) bool {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+	if m.Called(method, args...) {
+		return true
+	}
+	t.Errorf("expected a call to %s with arguments %v, got calls: %v", method, args, m.Calls(method))
+	return false
+}
+
+// AssertNotCalled reports an error to t if method was called with args, or at all if no args are given
+func (m *Mock) AssertNotCalled(t TestingT, method string, args ...interface{}) bool {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+	if !m.Called(method, args...) {
+		return true
+	}
+	t.Errorf("unexpected call to %s with arguments %v, got calls: %v", method, args, m.Calls(method))
+	return false
+}
+
+// AssertNumberOfCalls reports an error to t unless method was called n times
+func (m *Mock) AssertNumberOfCalls(t TestingT, method string, n int) bool {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+	if calls := len(m.Calls(method)); calls != n {
+		t.Errorf("expected %d calls to %s, got %d", n, method, calls)
+		return false
+	}
+	return true
+}
+
+// matches returns whether the leading arguments of call match expected
+func (call MockCall) matches(expected []interface{}) bool {
+	if len(expected) > len(call.Args) {
+		return false
+	}
+	for i, e := range expected {
+		if !argMatches(e, call.Args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func argMatches(expected interface{}, arg interface{}) bool {
+	if matcher, ok := expected.(ArgMatcher); ok {
+		return matcher(arg)
+	}
+	if expected == nil {
+		return isNil(arg)
+	}
+	return reflect.DeepEqual(expected, arg)
+}
+
+// isNil returns whether v is nil or a nil pointer, slice, map, func, chan or interface
+func isNil(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Func, reflect.Chan, reflect.Interface:
+		return rv.IsNil()
+	}
+	return false
+}
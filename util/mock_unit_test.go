@@ -0,0 +1,86 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package util
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingT records the errors reported by Mock assertions
+type recordingT struct {
+	errors []string
+}
+
+func (t *recordingT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+type query struct {
+	Count int
+}
+
+func TestMockCalls(t *testing.T) {
+	var m Mock
+	m.Record("GetJob", "sid1", []*http.Response(nil))
+	m.Record("ListJobs", &query{Count: 2}, []*http.Response(nil))
+	m.Record("GetJob", "sid2", []*http.Response(nil))
+
+	require.Equal(t, 3, len(m.Calls("")))
+	calls := m.Calls("GetJob")
+	require.Equal(t, 2, len(calls))
+	assert.Equal(t, []interface{}{"sid2", []*http.Response(nil)}, calls[1].Args)
+
+	assert.True(t, m.Called("GetJob"))
+	assert.True(t, m.Called("GetJob", "sid1"), "trailing arguments may be omitted")
+	assert.True(t, m.Called("GetJob", "sid2", nil), "nil should match a nil slice")
+	assert.False(t, m.Called("GetJob", "sid3"))
+	assert.True(t, m.Called("ListJobs", &query{Count: 2}), "arguments should be compared deeply")
+	assert.True(t, m.Called("ListJobs", ArgMatcher(func(arg interface{}) bool { return arg.(*query).Count > 1 })))
+	assert.True(t, m.Called("GetJob", Anything, Anything))
+	assert.False(t, m.Called("GetJob", Anything, Anything, Anything))
+
+	m.Reset()
+	assert.Equal(t, 0, len(m.Calls("")))
+}
+
+func TestMockAssertions(t *testing.T) {
+	var m Mock
+	m.Record("DeleteJob", "sid1")
+	rt := &recordingT{}
+	assert.True(t, m.AssertCalled(rt, "DeleteJob", "sid1"))
+	assert.True(t, m.AssertNotCalled(rt, "GetJob"))
+	assert.True(t, m.AssertNumberOfCalls(rt, "DeleteJob", 1))
+	assert.Empty(t, rt.errors)
+
+	assert.False(t, m.AssertCalled(rt, "DeleteJob", "sid2"))
+	assert.False(t, m.AssertNotCalled(rt, "DeleteJob"))
+	assert.False(t, m.AssertNumberOfCalls(rt, "DeleteJob", 2))
+	assert.Equal(t, 3, len(rt.errors))
+}
+
+func TestMockNotStubbed(t *testing.T) {
+	var m Mock
+	err := m.NotStubbed("GetJob")
+	assert.True(t, errors.Is(err, ErrNotStubbed))
+	assert.Contains(t, err.Error(), "GetJob")
+}
@@ -0,0 +1,181 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+// PageFunc fetches the page of items at cursor, where the empty cursor is the first page, returning the
+// cursor of the following page or "" if it is the last page
+type PageFunc[T any] func(ctx context.Context, cursor string) ([]T, string, error)
+
+// Pager fetches the pages of a list operation one at a time, following the paging scheme of the service
+// (page tokens, offsets, ...) such that callers need not. Use Next() to advance through the pages:
+//
+//	pager := client.IdentityService.ListMembersPages(nil)
+//	for pager.Next(ctx) {
+//		members := pager.Page()
+//		...
+//	}
+//	err := pager.Err() // get any error encountered while paging
+//
+// or range over Pages() or Items() to do the same with an iterator. A Pager is not safe for concurrent use.
+type Pager[T any] struct {
+	fetch  PageFunc[T]
+	cursor string
+	done   bool
+	page   []T
+	err    error
+}
+
+// NewPager creates a Pager which fetches pages with fetch, starting from the first page
+func NewPager[T any](fetch PageFunc[T]) *Pager[T] {
+	return &Pager[T]{fetch: fetch}
+}
+
+// Next fetches the next page for reading with the Page method. It returns true on success, or false if
+// there are no more pages or an error occurred while fetching.
+func (p *Pager[T]) Next(ctx context.Context) bool {
+	if p.done {
+		return false
+	}
+	page, cursor, err := p.fetch(ctx, p.cursor)
+	if err != nil {
+		p.err = err
+		p.done = true
+		p.page = nil
+		return false
+	}
+	p.page = page
+	p.cursor = cursor
+	p.done = cursor == ""
+	return true
+}
+
+// Page returns the items of the page fetched by the last call to Next
+func (p *Pager[T]) Page() []T {
+	return p.page
+}
+
+// More returns whether there may be pages left to fetch
+func (p *Pager[T]) More() bool {
+	return !p.done
+}
+
+// Err returns the error encountered while paging, if any
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// All fetches the remaining pages and returns their items
+func (p *Pager[T]) All(ctx context.Context) ([]T, error) {
+	var items []T
+	for p.Next(ctx) {
+		items = append(items, p.page...)
+	}
+	return items, p.err
+}
+
+// Pages returns an iterator over the remaining pages, an error fetching a page is yielded once and ends
+// the iteration
+func (p *Pager[T]) Pages(ctx context.Context) iter.Seq2[[]T, error] {
+	return func(yield func([]T, error) bool) {
+		for p.Next(ctx) {
+			if !yield(p.page, nil) {
+				return
+			}
+		}
+		if p.err != nil {
+			yield(nil, p.err)
+		}
+	}
+}
+
+// Items returns an iterator over the items of the remaining pages, an error fetching a page is yielded
+// once and ends the iteration
+func (p *Pager[T]) Items(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for page, err := range p.Pages(ctx) {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Offset is the type of the offset and count query parameters of list operations paged by offset
+type Offset interface {
+	~int32 | ~int64
+}
+
+// NewOffsetPager creates a Pager for list operations paged by offset and count, starting from offset and
+// requesting count items per page if either is set. fetch is called with the offset of each page and
+// returns its items and, for services which report it, the total number of items. Paging ends with a page
+// which is empty, shorter than count or reaches the total.
+func NewOffsetPager[T any, N Offset](offset, count *N, fetch func(ctx context.Context, offset N) ([]T, *int64, error)) *Pager[T] {
+	var start, size N
+	if offset != nil {
+		start = *offset
+	}
+	if count != nil {
+		size = *count
+	}
+	return NewPager(func(ctx context.Context, cursor string) ([]T, string, error) {
+		current := start
+		if cursor != "" {
+			n, err := strconv.ParseInt(cursor, 10, 64)
+			if err != nil {
+				return nil, "", fmt.Errorf("util.Pager: invalid offset cursor %q: %s", cursor, err)
+			}
+			current = N(n)
+		}
+		items, total, err := fetch(ctx, current)
+		if err != nil {
+			return nil, "", err
+		}
+		next := current + N(len(items))
+		if len(items) == 0 || (size > 0 && N(len(items)) < size) || (total != nil && int64(next) >= *total) {
+			return items, "", nil
+		}
+		return items, strconv.FormatInt(int64(next), 10), nil
+	})
+}
+
+// PageTokenFromLink returns the value of the key query parameter of link, the link to the next page
+// returned by list operations paged by token, or "" if link is empty or has no such parameter
+func PageTokenFromLink(link string, key string) string {
+	if link == "" {
+		return ""
+	}
+	u, err := url.Parse(link)
+	if err != nil {
+		return ""
+	}
+	return u.Query().Get(key)
+}
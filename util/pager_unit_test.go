@@ -0,0 +1,152 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package util
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tokenPages returns a PageFunc over pages, recording the cursors it is called with
+func tokenPages(pages map[string][]int, next map[string]string, cursors *[]string) PageFunc[int] {
+	return func(_ context.Context, cursor string) ([]int, string, error) {
+		*cursors = append(*cursors, cursor)
+		return pages[cursor], next[cursor], nil
+	}
+}
+
+func TestPagerNext(t *testing.T) {
+	var cursors []string
+	pager := NewPager(tokenPages(
+		map[string][]int{"": {1, 2}, "a": {3, 4}, "b": {5}},
+		map[string]string{"": "a", "a": "b"},
+		&cursors))
+	var pages [][]int
+	for pager.Next(context.Background()) {
+		pages = append(pages, pager.Page())
+	}
+	require.NoError(t, pager.Err())
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, pages)
+	assert.Equal(t, []string{"", "a", "b"}, cursors)
+	assert.False(t, pager.More())
+	assert.False(t, pager.Next(context.Background()))
+}
+
+func TestPagerAll(t *testing.T) {
+	var cursors []string
+	items, err := NewPager(tokenPages(
+		map[string][]int{"": {1, 2}, "a": {3}},
+		map[string]string{"": "a"},
+		&cursors)).All(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, items)
+}
+
+func TestPagerError(t *testing.T) {
+	calls := 0
+	pager := NewPager(func(_ context.Context, cursor string) ([]int, string, error) {
+		calls++
+		if cursor == "" {
+			return []int{1}, "a", nil
+		}
+		return nil, "", errors.New("boom")
+	})
+	items, err := pager.All(context.Background())
+	assert.EqualError(t, err, "boom")
+	assert.Equal(t, []int{1}, items)
+	assert.False(t, pager.Next(context.Background()))
+	assert.Equal(t, 2, calls)
+}
+
+func TestPagerIterators(t *testing.T) {
+	var cursors []string
+	pages := map[string][]int{"": {1, 2}, "a": {3, 4}, "b": {5}}
+	next := map[string]string{"": "a", "a": "b"}
+	var items []int
+	for item, err := range NewPager(tokenPages(pages, next, &cursors)).Items(context.Background()) {
+		require.NoError(t, err)
+		items = append(items, item)
+	}
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, items)
+
+	// Breaking out of the loop stops fetching pages
+	cursors = nil
+	for page, err := range NewPager(tokenPages(pages, next, &cursors)).Pages(context.Background()) {
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2}, page)
+		break
+	}
+	assert.Equal(t, []string{""}, cursors)
+
+	var errs []error
+	pager := NewPager(func(_ context.Context, _ string) ([]int, string, error) {
+		return nil, "", errors.New("boom")
+	})
+	for _, err := range pager.Items(context.Background()) {
+		errs = append(errs, err)
+	}
+	require.Equal(t, 1, len(errs))
+	assert.EqualError(t, errs[0], "boom")
+}
+
+func TestOffsetPager(t *testing.T) {
+	data := []string{"a", "b", "c", "d", "e"}
+	var offsets []int32
+	fetch := func(count int32, total *int64) func(context.Context, int32) ([]string, *int64, error) {
+		return func(_ context.Context, offset int32) ([]string, *int64, error) {
+			offsets = append(offsets, offset)
+			end := offset + count
+			if int(end) > len(data) {
+				end = int32(len(data))
+			}
+			return data[offset:end], total, nil
+		}
+	}
+
+	// A short page ends paging
+	count := int32(2)
+	items, err := NewOffsetPager(nil, &count, fetch(2, nil)).All(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, data, items)
+	assert.Equal(t, []int32{0, 2, 4}, offsets)
+
+	// Without a count paging ends with an empty page
+	offsets = nil
+	start := int32(1)
+	items, err = NewOffsetPager(&start, nil, fetch(2, nil)).All(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, data[1:], items)
+	assert.Equal(t, []int32{1, 3, 5}, offsets)
+
+	// Paging ends once the total is reached
+	offsets = nil
+	total := int64(4)
+	items, err = NewOffsetPager[string, int32](nil, nil, fetch(2, &total)).All(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, data[:4], items)
+	assert.Equal(t, []int32{0, 2}, offsets)
+}
+
+func TestPageTokenFromLink(t *testing.T) {
+	assert.Equal(t, "abc", PageTokenFromLink("/identity/v3/members?page_token=abc&page_size=10", "page_token"))
+	assert.Equal(t, "", PageTokenFromLink("/identity/v3/members?page_size=10", "page_token"))
+	assert.Equal(t, "", PageTokenFromLink("", "page_token"))
+}
@@ -0,0 +1,472 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package util
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// RecorderMode determines whether a Recorder sends requests or replays recorded responses
+type RecorderMode int
+
+const (
+	// ModeReplay replays the responses of the cassette, requests which do not match an interaction fail
+	// with ErrInteractionNotFound and nothing is sent
+	ModeReplay RecorderMode = iota
+	// ModeRecord sends all requests and records them to a new cassette, replacing any existing one
+	ModeRecord
+	// ModeReplayOrRecord replays the cassette if it exists and records a new one otherwise
+	ModeReplayOrRecord
+	// ModePassthrough sends all requests without recording them
+	ModePassthrough
+)
+
+func (m RecorderMode) String() string {
+	switch m {
+	case ModeReplay:
+		return "replay"
+	case ModeRecord:
+		return "record"
+	case ModeReplayOrRecord:
+		return "replay-or-record"
+	case ModePassthrough:
+		return "passthrough"
+	}
+	return fmt.Sprintf("RecorderMode(%d)", int(m))
+}
+
+// ParseRecorderMode returns the RecorderMode named s, e.g. "replay" or "record", the empty string is ModeReplay
+func ParseRecorderMode(s string) (RecorderMode, error) {
+	for _, m := range []RecorderMode{ModeReplay, ModeRecord, ModeReplayOrRecord, ModePassthrough} {
+		if strings.EqualFold(s, m.String()) {
+			return m, nil
+		}
+	}
+	if s == "" {
+		return ModeReplay, nil
+	}
+	return ModeReplay, fmt.Errorf("util.ParseRecorderMode: unknown mode %q", s)
+}
+
+// ErrInteractionNotFound is matched by errors.Is for the error returned when replaying a request which does
+// not match any remaining interaction of the cassette
+var ErrInteractionNotFound = errors.New("no recorded interaction matches the request")
+
+// Cassette is a recording of the requests sent and responses received, stored as a JSON file
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a request and the response received
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request of a cassette
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+	// BodyEncoding is "base64" for bodies which are not valid UTF-8
+	BodyEncoding string `json:"bodyEncoding,omitempty"`
+}
+
+// RecordedResponse is a response of a cassette
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Status     string      `json:"status"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+	// BodyEncoding is "base64" for bodies which are not valid UTF-8
+	BodyEncoding string `json:"bodyEncoding,omitempty"`
+}
+
+// RequestMatcher returns whether a request to replay matches a recorded request, the request to replay has
+// credentials redacted and Replacements substituted as recorded requests do
+type RequestMatcher func(request *RecordedRequest, recorded *RecordedRequest) bool
+
+// MatchMethod matches requests with the same method
+func MatchMethod(request *RecordedRequest, recorded *RecordedRequest) bool {
+	return request.Method == recorded.Method
+}
+
+// MatchHost matches requests to the same host
+func MatchHost(request *RecordedRequest, recorded *RecordedRequest) bool {
+	u1, err1 := url.Parse(request.URL)
+	u2, err2 := url.Parse(recorded.URL)
+	return err1 == nil && err2 == nil && u1.Host == u2.Host
+}
+
+// MatchPath matches requests with the same URL path
+func MatchPath(request *RecordedRequest, recorded *RecordedRequest) bool {
+	u1, err1 := url.Parse(request.URL)
+	u2, err2 := url.Parse(recorded.URL)
+	return err1 == nil && err2 == nil && u1.Path == u2.Path
+}
+
+// MatchQuery matches requests with the same query parameters, in any order
+func MatchQuery(request *RecordedRequest, recorded *RecordedRequest) bool {
+	u1, err1 := url.Parse(request.URL)
+	u2, err2 := url.Parse(recorded.URL)
+	return err1 == nil && err2 == nil && reflect.DeepEqual(sortedQuery(u1), sortedQuery(u2))
+}
+
+// MatchBody matches requests with the same body, JSON bodies match if they are equivalent
+func MatchBody(request *RecordedRequest, recorded *RecordedRequest) bool {
+	if request.Body == recorded.Body {
+		return true
+	}
+	var v1, v2 interface{}
+	if json.Unmarshal([]byte(request.Body), &v1) != nil || json.Unmarshal([]byte(recorded.Body), &v2) != nil {
+		return false
+	}
+	return reflect.DeepEqual(v1, v2)
+}
+
+func sortedQuery(u *url.URL) url.Values {
+	values := u.Query()
+	for _, v := range values {
+		sort.Strings(v)
+	}
+	return values
+}
+
+// DefaultMatchers match requests by method, path and query parameters
+var DefaultMatchers = []RequestMatcher{MatchMethod, MatchPath, MatchQuery}
+
+// RecorderOptions configures a Recorder, all fields are optional
+type RecorderOptions struct {
+	// Mode is ModeReplay by default
+	Mode RecorderMode
+	// Transport sends requests when recording, http.DefaultTransport by default
+	Transport http.RoundTripper
+	// Matchers must all match for a request to be replayed from an interaction, DefaultMatchers by default
+	Matchers []RequestMatcher
+	// Replacements are substituted in the URLs, headers and bodies of recorded interactions, and of requests
+	// before they are matched, e.g. {"mytenant": "testtenant"} records tenant names as "testtenant"
+	Replacements map[string]string
+	// RedactHeaders are the names of headers to redact in addition to Authorization, Proxy-Authorization,
+	// Cookie and Set-Cookie
+	RedactHeaders []string
+	// Scrub (optional) is called to modify each interaction before it is recorded, after credentials have
+	// been redacted and Replacements substituted
+	Scrub func(interaction *Interaction)
+}
+
+// Recorder is a RoundTripper which records requests and their responses to a cassette file, and replays them
+// without sending any requests, such that tests against a real environment can be recorded once and run
+// offline and deterministically afterwards. Credentials such as the Authorization header, tokens and secrets
+// are redacted from recordings. When replaying, each request is matched with the first interaction of the
+// cassette not yet replayed which all Matchers match, so repeated requests, e.g. polling for a search job
+// to complete, are replayed in order:
+//
+//	recorder, err := util.NewRecorder("testdata/list_datasets.json", util.RecorderOptions{Mode: util.ModeReplayOrRecord})
+//	client, err := sdk.NewClient(&services.Config{RoundTripper: recorder, ...})
+//
+// When recording the cassette is saved after each interaction.
+type Recorder struct {
+	path          string
+	mode          RecorderMode
+	transport     http.RoundTripper
+	matchers      []RequestMatcher
+	replacements  map[string]string
+	redactHeaders []string
+	scrub         func(*Interaction)
+	mux           sync.Mutex
+	cassette      *Cassette
+	replayed      []bool
+}
+
+// NewRecorder creates a Recorder for the cassette file at path, which is read for ModeReplay and
+// ModeReplayOrRecord if it exists
+func NewRecorder(path string, options RecorderOptions) (*Recorder, error) {
+	r := &Recorder{
+		path:          path,
+		mode:          options.Mode,
+		transport:     options.Transport,
+		matchers:      options.Matchers,
+		replacements:  options.Replacements,
+		redactHeaders: append(append([]string{}, redactedHeaders...), options.RedactHeaders...),
+		scrub:         options.Scrub,
+		cassette:      &Cassette{},
+	}
+	if r.transport == nil {
+		r.transport = http.DefaultTransport
+	}
+	if len(r.matchers) == 0 {
+		r.matchers = DefaultMatchers
+	}
+	switch r.mode {
+	case ModeReplay, ModeReplayOrRecord:
+		content, err := ioutil.ReadFile(path)
+		if os.IsNotExist(err) && r.mode == ModeReplayOrRecord {
+			r.mode = ModeRecord
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("util.NewRecorder: error reading cassette: %s", err)
+		}
+		if err := json.Unmarshal(content, r.cassette); err != nil {
+			return nil, fmt.Errorf("util.NewRecorder: error parsing cassette %s: %s", path, err)
+		}
+		r.mode = ModeReplay
+		r.replayed = make([]bool, len(r.cassette.Interactions))
+	}
+	return r, nil
+}
+
+// Mode returns whether the recorder is replaying, recording or passing requests through, ModeReplayOrRecord
+// is resolved to ModeReplay or ModeRecord when the recorder is created
+func (r *Recorder) Mode() RecorderMode {
+	return r.mode
+}
+
+// Cassette returns the interactions recorded, or being replayed
+func (r *Recorder) Cassette() *Cassette {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	return r.cassette
+}
+
+// RoundTrip implements the RoundTripper interface
+func (r *Recorder) RoundTrip(request *http.Request) (*http.Response, error) {
+	switch r.mode {
+	case ModePassthrough:
+		return r.transport.RoundTrip(request)
+	case ModeReplay:
+		return r.replay(request)
+	}
+	return r.record(request)
+}
+
+// replay returns the response of the first interaction not yet replayed matching request
+func (r *Recorder) replay(request *http.Request) (*http.Response, error) {
+	recorded, err := r.recordRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	for i, interaction := range r.cassette.Interactions {
+		if r.replayed[i] || !r.matches(recorded, &interaction.Request) {
+			continue
+		}
+		r.replayed[i] = true
+		return interaction.Response.response(request)
+	}
+	return nil, fmt.Errorf("%w: %s %s in %s", ErrInteractionNotFound, recorded.Method, recorded.URL, r.path)
+}
+
+func (r *Recorder) matches(request *RecordedRequest, recorded *RecordedRequest) bool {
+	for _, match := range r.matchers {
+		if !match(request, recorded) {
+			return false
+		}
+	}
+	return true
+}
+
+// record sends request and saves the interaction to the cassette
+func (r *Recorder) record(request *http.Request) (*http.Response, error) {
+	recorded, err := r.recordRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	response, err := r.transport.RoundTrip(request)
+	if err != nil {
+		return nil, err
+	}
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	response.Body = ioutil.NopCloser(bytes.NewReader(body))
+	interaction := &Interaction{
+		Request: *recorded,
+		Response: RecordedResponse{
+			StatusCode: response.StatusCode,
+			Status:     response.Status,
+			Header:     r.scrubHeader(response.Header),
+		},
+	}
+	interaction.Response.Body, interaction.Response.BodyEncoding = r.scrubBody(body)
+	if r.scrub != nil {
+		r.scrub(interaction)
+	}
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	if err := r.save(); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// save writes the cassette to its file, r.mux must be held
+func (r *Recorder) save() error {
+	content, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if dir := filepath.Dir(r.path); dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("util.Recorder: error saving cassette: %s", err)
+		}
+	}
+	if err := ioutil.WriteFile(r.path, content, 0644); err != nil {
+		return fmt.Errorf("util.Recorder: error saving cassette: %s", err)
+	}
+	return nil
+}
+
+// recordRequest returns the scrubbed recording of request, the request body is read and replaced
+func (r *Recorder) recordRequest(request *http.Request) (*RecordedRequest, error) {
+	var body []byte
+	if request.Body != nil && request.Body != http.NoBody {
+		var err error
+		if body, err = ioutil.ReadAll(request.Body); err != nil {
+			return nil, err
+		}
+		request.Body.Close()
+		request.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	u := *request.URL
+	if u.RawQuery != "" {
+		u.RawQuery = redactValues(u.Query()).Encode()
+	}
+	recorded := &RecordedRequest{
+		Method: request.Method,
+		URL:    r.replace(u.String()),
+		Header: r.scrubHeader(request.Header),
+	}
+	if request.Header.Get("Content-Type") == "application/x-www-form-urlencoded" {
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			body = []byte(redactValues(values).Encode())
+		}
+	}
+	recorded.Body, recorded.BodyEncoding = r.scrubBody(body)
+	return recorded, nil
+}
+
+// scrubHeader returns a copy of header with credentials redacted and replacements substituted
+func (r *Recorder) scrubHeader(header http.Header) http.Header {
+	if len(header) == 0 {
+		return nil
+	}
+	scrubbed := make(http.Header, len(header))
+	for name, values := range header {
+		for _, v := range values {
+			scrubbed[name] = append(scrubbed[name], r.replace(v))
+		}
+	}
+	for _, name := range r.redactHeaders {
+		if _, ok := scrubbed[http.CanonicalHeaderKey(name)]; ok {
+			scrubbed.Set(name, Redacted)
+		}
+	}
+	return scrubbed
+}
+
+// scrubBody returns the body with credential fields of JSON redacted and replacements substituted, bodies
+// which are not valid UTF-8 are base64 encoded
+func (r *Recorder) scrubBody(body []byte) (string, string) {
+	if len(body) == 0 {
+		return "", ""
+	}
+	if !utf8.Valid(body) {
+		return base64.StdEncoding.EncodeToString(body), "base64"
+	}
+	var v interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if decoder.Decode(&v) == nil && !decoder.More() {
+		// bodies are only re-encoded if a field was redacted, so that other bodies are recorded unchanged
+		original, err := json.Marshal(v)
+		redacted, rerr := json.Marshal(redactJSON(v, "", nil))
+		if err == nil && rerr == nil && !bytes.Equal(original, redacted) {
+			body = redacted
+		}
+	}
+	return r.replace(string(body)), ""
+}
+
+// replace substitutes the replacements in s, longest first so that overlapping values are replaced consistently
+func (r *Recorder) replace(s string) string {
+	if len(r.replacements) == 0 {
+		return s
+	}
+	olds := make([]string, 0, len(r.replacements))
+	for old := range r.replacements {
+		if old != "" {
+			olds = append(olds, old)
+		}
+	}
+	sort.Slice(olds, func(i, j int) bool {
+		return len(olds[i]) > len(olds[j]) || (len(olds[i]) == len(olds[j]) && olds[i] < olds[j])
+	})
+	for _, old := range olds {
+		s = strings.ReplaceAll(s, old, r.replacements[old])
+	}
+	return s
+}
+
+// response returns an *http.Response for request with the recorded status, headers and body
+func (rr *RecordedResponse) response(request *http.Request) (*http.Response, error) {
+	body := []byte(rr.Body)
+	if rr.BodyEncoding == "base64" {
+		var err error
+		if body, err = base64.StdEncoding.DecodeString(rr.Body); err != nil {
+			return nil, fmt.Errorf("util.Recorder: invalid recorded body: %s", err)
+		}
+	}
+	header := rr.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	status := rr.Status
+	if status == "" {
+		status = fmt.Sprintf("%d %s", rr.StatusCode, http.StatusText(rr.StatusCode))
+	}
+	return &http.Response{
+		Status:        status,
+		StatusCode:    rr.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       request,
+	}, nil
+}
@@ -0,0 +1,165 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package util
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordedServer responds to requests with a count of the requests to the same path and the length of the
+// request body, and to /token with a token
+func recordedServer() *httptest.Server {
+	counts := map[string]int{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/token" {
+			_, _ = w.Write([]byte(`{"access_token":"secret.jwt.value","token_type":"Bearer"}`))
+			return
+		}
+		counts[r.URL.Path]++
+		body, _ := ioutil.ReadAll(r.Body)
+		fmt.Fprintf(w, `{"path":%q,"count":%d,"length":%d}`, r.URL.Path, counts[r.URL.Path], len(body))
+	}))
+}
+
+func send(t *testing.T, rt http.RoundTripper, method string, u string, body string) (string, error) {
+	req, err := http.NewRequest(method, u, strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer secret.jwt.value")
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	content, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(content), nil
+}
+
+func TestRecorderRecordAndReplay(t *testing.T) {
+	server := recordedServer()
+	cassette := filepath.Join(t.TempDir(), "cassettes", "test.json")
+	options := RecorderOptions{Mode: ModeReplayOrRecord, Replacements: map[string]string{"realtenant": "testtenant"}}
+	recorder, err := NewRecorder(cassette, options)
+	require.NoError(t, err)
+	assert.Equal(t, ModeRecord, recorder.Mode())
+
+	recorded := []string{}
+	for _, path := range []string{"/realtenant/jobs", "/realtenant/jobs", "/token"} {
+		body, err := send(t, recorder, http.MethodGet, server.URL+path+"?b=2&a=1", "")
+		require.NoError(t, err)
+		recorded = append(recorded, body)
+	}
+	_, err = send(t, recorder, http.MethodPost, server.URL+"/realtenant/jobs", `{"query":"from main","password":"hunter2"}`)
+	require.NoError(t, err)
+	server.Close()
+
+	content, err := ioutil.ReadFile(cassette)
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "secret.jwt.value")
+	assert.NotContains(t, string(content), "hunter2")
+	assert.NotContains(t, string(content), "realtenant")
+	assert.Contains(t, string(content), "/testtenant/jobs")
+	require.Equal(t, 4, len(recorder.Cassette().Interactions))
+	assert.Equal(t, []string{Redacted}, recorder.Cassette().Interactions[0].Request.Header["Authorization"])
+
+	// Replay with the server gone, repeated requests are replayed in order
+	options.Mode = ModeReplayOrRecord
+	options.Matchers = append([]RequestMatcher{MatchBody}, DefaultMatchers...)
+	replayer, err := NewRecorder(cassette, options)
+	require.NoError(t, err)
+	assert.Equal(t, ModeReplay, replayer.Mode())
+	body, err := send(t, replayer, http.MethodGet, server.URL+"/realtenant/jobs?a=1&b=2", "")
+	require.NoError(t, err)
+	assert.Equal(t, strings.ReplaceAll(recorded[0], "realtenant", "testtenant"), body)
+	body, err = send(t, replayer, http.MethodGet, server.URL+"/realtenant/jobs?b=2&a=1", "")
+	require.NoError(t, err)
+	assert.Contains(t, body, `"count":2`)
+	body, err = send(t, replayer, http.MethodGet, server.URL+"/token?b=2&a=1", "")
+	require.NoError(t, err)
+	assert.Contains(t, body, `"access_token":"REDACTED"`)
+
+	// Bodies are matched once scrubbed, JSON in any field order
+	_, err = send(t, replayer, http.MethodPost, server.URL+"/realtenant/jobs", `{"query":"from other"}`)
+	assert.True(t, errors.Is(err, ErrInteractionNotFound))
+	_, err = send(t, replayer, http.MethodPost, server.URL+"/realtenant/jobs", `{"password":"other","query":"from main"}`)
+	require.NoError(t, err)
+
+	// Every interaction has been replayed
+	_, err = send(t, replayer, http.MethodGet, server.URL+"/realtenant/jobs?a=1&b=2", "")
+	assert.True(t, errors.Is(err, ErrInteractionNotFound))
+}
+
+func TestRecorderReplayMissingCassette(t *testing.T) {
+	_, err := NewRecorder(filepath.Join(t.TempDir(), "missing.json"), RecorderOptions{})
+	assert.Error(t, err)
+}
+
+func TestRecorderPassthrough(t *testing.T) {
+	server := recordedServer()
+	defer server.Close()
+	cassette := filepath.Join(t.TempDir(), "test.json")
+	recorder, err := NewRecorder(cassette, RecorderOptions{Mode: ModePassthrough})
+	require.NoError(t, err)
+	body, err := send(t, recorder, http.MethodGet, server.URL+"/jobs", "")
+	require.NoError(t, err)
+	assert.Contains(t, body, `"count":1`)
+	assert.NoFileExists(t, cassette)
+}
+
+func TestRecorderBinaryBodies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte{0xff, 0xfe, 0x00})
+	}))
+	cassette := filepath.Join(t.TempDir(), "test.json")
+	recorder, err := NewRecorder(cassette, RecorderOptions{Mode: ModeRecord})
+	require.NoError(t, err)
+	_, err = send(t, recorder, http.MethodGet, server.URL+"/file", "")
+	require.NoError(t, err)
+	server.Close()
+	assert.Equal(t, "base64", recorder.Cassette().Interactions[0].Response.BodyEncoding)
+
+	replayer, err := NewRecorder(cassette, RecorderOptions{})
+	require.NoError(t, err)
+	body, err := send(t, replayer, http.MethodGet, server.URL+"/file", "")
+	require.NoError(t, err)
+	assert.Equal(t, string([]byte{0xff, 0xfe, 0x00}), body)
+}
+
+func TestParseRecorderMode(t *testing.T) {
+	for _, mode := range []RecorderMode{ModeReplay, ModeRecord, ModeReplayOrRecord, ModePassthrough} {
+		parsed, err := ParseRecorderMode(mode.String())
+		require.NoError(t, err)
+		assert.Equal(t, mode, parsed)
+	}
+	mode, err := ParseRecorderMode("")
+	require.NoError(t, err)
+	assert.Equal(t, ModeReplay, mode)
+	_, err = ParseRecorderMode("rewind")
+	assert.Error(t, err)
+}
@@ -28,6 +28,8 @@ type Logger interface {
 }
 
 // SdkTransport is to define a transport RoundTripper with user-defined logger
+//
+// Deprecated: SdkTransport logs credentials such as the Authorization header, use LogTransport instead
 type SdkTransport struct {
 	transport http.RoundTripper
 	logger    Logger
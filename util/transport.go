@@ -0,0 +1,220 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package util
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// TransportConfig configures the connections made to Splunk Cloud services and the identity provider, unset
+// fields default to the settings of http.DefaultTransport
+type TransportConfig struct {
+	// ClientCertFile and ClientKeyFile are (optional) PEM files of a certificate and key presented for mutual TLS
+	ClientCertFile string
+	ClientKeyFile  string
+	// ClientCertificates are (optional) certificates presented for mutual TLS, in addition to ClientCertFile
+	ClientCertificates []tls.Certificate
+	// RootCAFiles are (optional) PEM files of CA certificates trusted in addition to the system's
+	RootCAFiles []string
+	// RootCAPEM is (optional) PEM encoded CA certificates trusted in addition to the system's
+	RootCAPEM []byte
+	// InsecureSkipVerify if true skips verification of the server's certificate, do not use in production
+	InsecureSkipVerify bool
+	// ProxyURL is the (optional) proxy used for all requests, e.g. "http://proxy.example.com:3128", by default
+	// proxies are taken from the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables
+	ProxyURL string
+	// NoProxy is an (optional) comma separated list of hosts, domains and CIDRs which are not proxied, in the
+	// format of NO_PROXY - NO_PROXY is used if unset
+	NoProxy string
+	// MaxIdleConns is the (optional) maximum number of idle connections across all hosts, 100 by default
+	MaxIdleConns int
+	// MaxIdleConnsPerHost is the (optional) maximum number of idle connections to each host, 2 by default
+	MaxIdleConnsPerHost int
+	// MaxConnsPerHost is the (optional) maximum number of connections to each host, unlimited by default
+	MaxConnsPerHost int
+	// IdleConnTimeout is the (optional) time an idle connection is kept open, 90 seconds by default
+	IdleConnTimeout time.Duration
+	// DisableHTTP2 if true only uses HTTP/1.1
+	DisableHTTP2 bool
+	// DialTimeout is the (optional) time allowed to establish a connection, 30 seconds by default
+	DialTimeout time.Duration
+	// TLSHandshakeTimeout is the (optional) time allowed for the TLS handshake, 10 seconds by default
+	TLSHandshakeTimeout time.Duration
+	// ResponseHeaderTimeout is the (optional) time allowed to receive the response headers once the request
+	// has been sent, unlimited by default. Unlike a client timeout it does not limit reading the response body
+	ResponseHeaderTimeout time.Duration
+}
+
+// NewTransport creates an http.Transport configured by cfg
+func NewTransport(cfg *TransportConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg == nil {
+		return transport, nil
+	}
+	tlsConfig, err := cfg.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	transport.TLSClientConfig = tlsConfig
+	if cfg.ProxyURL != "" || cfg.NoProxy != "" {
+		proxy, err := cfg.proxy()
+		if err != nil {
+			return nil, err
+		}
+		transport.Proxy = proxy
+	}
+	if cfg.MaxIdleConns != 0 {
+		transport.MaxIdleConns = cfg.MaxIdleConns
+	}
+	if cfg.MaxIdleConnsPerHost != 0 {
+		transport.MaxIdleConnsPerHost = cfg.MaxIdleConnsPerHost
+	}
+	if cfg.MaxConnsPerHost != 0 {
+		transport.MaxConnsPerHost = cfg.MaxConnsPerHost
+	}
+	if cfg.IdleConnTimeout != 0 {
+		transport.IdleConnTimeout = cfg.IdleConnTimeout
+	}
+	if cfg.TLSHandshakeTimeout != 0 {
+		transport.TLSHandshakeTimeout = cfg.TLSHandshakeTimeout
+	}
+	if cfg.ResponseHeaderTimeout != 0 {
+		transport.ResponseHeaderTimeout = cfg.ResponseHeaderTimeout
+	}
+	if cfg.DialTimeout != 0 {
+		transport.DialContext = (&net.Dialer{Timeout: cfg.DialTimeout, KeepAlive: 30 * time.Second}).DialContext
+	}
+	if cfg.DisableHTTP2 {
+		// A non-nil, empty TLSNextProto disables HTTP/2
+		transport.ForceAttemptHTTP2 = false
+		transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+	}
+	return transport, nil
+}
+
+// tlsConfig returns the TLS configuration for cfg's certificates
+func (cfg *TransportConfig) tlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: cfg.InsecureSkipVerify} //nolint:gosec
+	tlsConfig.Certificates = append(tlsConfig.Certificates, cfg.ClientCertificates...)
+	if cfg.ClientCertFile != "" || cfg.ClientKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.ClientCertFile, cfg.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("util.NewTransport: error loading client certificate: %s", err)
+		}
+		tlsConfig.Certificates = append(tlsConfig.Certificates, cert)
+	}
+	if len(cfg.RootCAFiles) == 0 && len(cfg.RootCAPEM) == 0 {
+		return tlsConfig, nil
+	}
+	rootCAs, err := x509.SystemCertPool()
+	if err != nil || rootCAs == nil {
+		rootCAs = x509.NewCertPool()
+	}
+	for _, file := range cfg.RootCAFiles {
+		certs, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("util.NewTransport: error reading root CA file: %s", err)
+		}
+		if !rootCAs.AppendCertsFromPEM(certs) {
+			return nil, fmt.Errorf("util.NewTransport: no certificates found in root CA file: %s", file)
+		}
+	}
+	if len(cfg.RootCAPEM) > 0 && !rootCAs.AppendCertsFromPEM(cfg.RootCAPEM) {
+		return nil, errors.New("util.NewTransport: no certificates found in RootCAPEM")
+	}
+	tlsConfig.RootCAs = rootCAs
+	return tlsConfig, nil
+}
+
+// proxy returns the proxy func for cfg's ProxyURL and NoProxy, using the environment for anything unset
+func (cfg *TransportConfig) proxy() (func(*http.Request) (*url.URL, error), error) {
+	var proxyURL *url.URL
+	if cfg.ProxyURL != "" {
+		var err error
+		if proxyURL, err = url.Parse(cfg.ProxyURL); err != nil {
+			return nil, fmt.Errorf("util.NewTransport: invalid ProxyURL: %s", err)
+		}
+	}
+	noProxy := cfg.NoProxy
+	if noProxy == "" {
+		noProxy = getenvAny("NO_PROXY", "no_proxy")
+	}
+	return func(req *http.Request) (*url.URL, error) {
+		if skipProxy(req.URL, noProxy) {
+			return nil, nil
+		}
+		if proxyURL != nil {
+			return proxyURL, nil
+		}
+		return http.ProxyFromEnvironment(req)
+	}, nil
+}
+
+// getenvAny returns the value of the first of names set in the environment
+func getenvAny(names ...string) string {
+	for _, name := range names {
+		if v := os.Getenv(name); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// skipProxy returns true if u matches an entry of noProxy, a comma separated list of "*", IP addresses,
+// CIDRs, hosts (optionally with a port) and domains (which also match their subdomains)
+func skipProxy(u *url.URL, noProxy string) bool {
+	host, port := u.Hostname(), u.Port()
+	ip := net.ParseIP(host)
+	for _, entry := range strings.Split(noProxy, ",") {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "" {
+			continue
+		}
+		if entry == "*" {
+			return true
+		}
+		if _, cidr, err := net.ParseCIDR(entry); err == nil {
+			if ip != nil && cidr.Contains(ip) {
+				return true
+			}
+			continue
+		}
+		entryHost, entryPort, err := net.SplitHostPort(entry)
+		if err != nil {
+			entryHost, entryPort = entry, ""
+		}
+		if entryPort != "" && entryPort != port {
+			continue
+		}
+		entryHost = strings.TrimPrefix(entryHost, "*")
+		name := strings.ToLower(host)
+		if name == strings.TrimPrefix(entryHost, ".") || strings.HasSuffix(name, "."+strings.TrimPrefix(entryHost, ".")) {
+			return true
+		}
+	}
+	return false
+}
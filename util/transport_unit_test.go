@@ -0,0 +1,140 @@
+/*
+ * Copyright © 2023 Splunk, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"): you may
+ * not use this file except in compliance with the License. You may obtain
+ * a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ */
+
+package util
+
+import (
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTransportDefaults(t *testing.T) {
+	transport, err := NewTransport(nil)
+	require.NoError(t, err)
+	assert.Equal(t, 100, transport.MaxIdleConns)
+	assert.True(t, transport.ForceAttemptHTTP2)
+
+	transport, err = NewTransport(&TransportConfig{
+		MaxIdleConns:          10,
+		MaxIdleConnsPerHost:   5,
+		MaxConnsPerHost:       20,
+		IdleConnTimeout:       time.Second,
+		TLSHandshakeTimeout:   2 * time.Second,
+		DialTimeout:           3 * time.Second,
+		ResponseHeaderTimeout: 4 * time.Second,
+		DisableHTTP2:          true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 10, transport.MaxIdleConns)
+	assert.Equal(t, 5, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, 20, transport.MaxConnsPerHost)
+	assert.Equal(t, time.Second, transport.IdleConnTimeout)
+	assert.Equal(t, 2*time.Second, transport.TLSHandshakeTimeout)
+	assert.Equal(t, 4*time.Second, transport.ResponseHeaderTimeout)
+	assert.False(t, transport.ForceAttemptHTTP2)
+	assert.NotNil(t, transport.TLSNextProto)
+	assert.Empty(t, transport.TLSNextProto)
+}
+
+func TestNewTransportRootCAs(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	// Without the server's CA the request should fail verification
+	transport, err := NewTransport(&TransportConfig{})
+	require.NoError(t, err)
+	_, err = (&http.Client{Transport: transport}).Get(server.URL)
+	assert.Error(t, err)
+
+	transport, err = NewTransport(&TransportConfig{RootCAPEM: certPEM})
+	require.NoError(t, err)
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, ioutil.WriteFile(caFile, certPEM, 0600))
+	transport, err = NewTransport(&TransportConfig{RootCAFiles: []string{caFile}})
+	require.NoError(t, err)
+	resp, err = (&http.Client{Transport: transport}).Get(server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+}
+
+func TestNewTransportInvalidCertificates(t *testing.T) {
+	_, err := NewTransport(&TransportConfig{RootCAFiles: []string{filepath.Join(t.TempDir(), "missing.pem")}})
+	assert.Error(t, err)
+	_, err = NewTransport(&TransportConfig{RootCAPEM: []byte("not a certificate")})
+	assert.Error(t, err)
+	_, err = NewTransport(&TransportConfig{ClientCertFile: "missing.crt", ClientKeyFile: "missing.key"})
+	assert.Error(t, err)
+}
+
+func TestNewTransportProxy(t *testing.T) {
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = append(proxied, r.URL.String())
+	}))
+	defer proxy.Close()
+	transport, err := NewTransport(&TransportConfig{ProxyURL: proxy.URL, NoProxy: "internal.example.com"})
+	require.NoError(t, err)
+	resp, err := (&http.Client{Transport: transport}).Get("http://api.example.com/widgets")
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, []string{"http://api.example.com/widgets"}, proxied)
+
+	req, err := http.NewRequest(http.MethodGet, "http://api.internal.example.com/widgets", nil)
+	require.NoError(t, err)
+	proxyURL, err := transport.Proxy(req)
+	require.NoError(t, err)
+	assert.Nil(t, proxyURL)
+}
+
+func TestSkipProxy(t *testing.T) {
+	tests := []struct {
+		url     string
+		noProxy string
+		skip    bool
+	}{
+		{"https://api.scp.splunk.com", "", false},
+		{"https://api.scp.splunk.com", "*", true},
+		{"https://api.scp.splunk.com", "scp.splunk.com", true},
+		{"https://api.scp.splunk.com", ".scp.splunk.com", true},
+		{"https://api.scp.splunk.com", "*.scp.splunk.com", true},
+		{"https://api.scp.splunk.com", "example.com, api.scp.splunk.com", true},
+		{"https://api.scp.splunk.com", "splunk.co", false},
+		{"https://api.scp.splunk.com", "api.scp.splunk.com:8443", false},
+		{"https://api.scp.splunk.com:8443", "api.scp.splunk.com:8443", true},
+		{"http://10.1.2.3:8080", "10.0.0.0/8", true},
+		{"http://192.168.1.1", "10.0.0.0/8", false},
+		{"http://10.1.2.3", "10.1.2.3", true},
+	}
+	for _, tt := range tests {
+		u, err := url.Parse(tt.url)
+		require.NoError(t, err)
+		assert.Equal(t, tt.skip, skipProxy(u, tt.noProxy), "%s with NO_PROXY=%s", tt.url, tt.noProxy)
+	}
+}